@@ -0,0 +1,147 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/slack-go/slack"
+)
+
+// rawBlock is a Block Kit block sent to Slack exactly as it was configured.
+// Going through the slack-go block types would drop the attributes of any
+// block type the library does not know about.
+type rawBlock struct {
+	blockType string
+	blockID   string
+	raw       json.RawMessage
+}
+
+// BlockType returns the type of the block.
+func (b rawBlock) BlockType() slack.MessageBlockType {
+	return slack.MessageBlockType(b.blockType)
+}
+
+// ID returns the ID of the block.
+func (b rawBlock) ID() string {
+	return b.blockID
+}
+
+// MarshalJSON returns the block as it was configured.
+func (b rawBlock) MarshalJSON() ([]byte, error) {
+	return b.raw, nil
+}
+
+// parseBlocks parses a JSON array of Block Kit blocks.
+func parseBlocks(blocks string) ([]slack.Block, error) {
+	var raws []json.RawMessage
+	if err := json.Unmarshal([]byte(blocks), &raws); err != nil {
+		return nil, fmt.Errorf("blocks must be a JSON array of Block Kit blocks: %s", err)
+	}
+
+	parsed := make([]slack.Block, 0, len(raws))
+	for i, raw := range raws {
+		var header struct {
+			Type    string `json:"type"`
+			BlockID string `json:"block_id"`
+		}
+		if err := json.Unmarshal(raw, &header); err != nil {
+			return nil, fmt.Errorf("block %d must be a JSON object: %s", i, err)
+		}
+		if header.Type == "" {
+			return nil, fmt.Errorf("block %d is missing its type", i)
+		}
+
+		parsed = append(parsed, rawBlock{blockType: header.Type, blockID: header.BlockID, raw: raw})
+	}
+
+	return parsed, nil
+}
+
+// maxMessageBlocks is the number of blocks Slack accepts in a message.
+const maxMessageBlocks = 50
+
+// blockRequiredFields lists, for every Block Kit block type that can be used
+// in a message, the fields which must be present. Entries separated by a "|"
+// are alternatives of which at least one must be present.
+var blockRequiredFields = map[string][]string{
+	"actions":   {"elements"},
+	"context":   {"elements"},
+	"divider":   {},
+	"file":      {"external_id", "source"},
+	"header":    {"text"},
+	"image":     {"alt_text", "image_url|slack_file"},
+	"input":     {"label", "element"},
+	"markdown":  {"text"},
+	"rich_text": {"elements"},
+	"section":   {"text|fields|accessory"},
+	"video":     {"alt_text", "title", "thumbnail_url", "video_url"},
+}
+
+// validateBlocks checks that blocks is a JSON array of Block Kit blocks with
+// a known type and the fields that type requires.
+func validateBlocks(blocks string) error {
+	var raws []map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(blocks), &raws); err != nil {
+		return fmt.Errorf("blocks must be a JSON array of Block Kit objects: %s", err)
+	}
+
+	if len(raws) > maxMessageBlocks {
+		return fmt.Errorf("a message can contain at most %d blocks, got %d", maxMessageBlocks, len(raws))
+	}
+
+	for i, raw := range raws {
+		var blockType string
+		if err := json.Unmarshal(raw["type"], &blockType); err != nil || blockType == "" {
+			return fmt.Errorf("block %d must have a string type", i)
+		}
+
+		required, ok := blockRequiredFields[blockType]
+		if !ok {
+			return fmt.Errorf("block %d has unsupported type %q", i, blockType)
+		}
+
+		for _, field := range required {
+			found := false
+			for _, alternative := range strings.Split(field, "|") {
+				if _, ok := raw[alternative]; ok {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return fmt.Errorf("%s block %d is missing %s", blockType, i, strings.ReplaceAll(field, "|", " or "))
+			}
+		}
+	}
+
+	return nil
+}
+
+var _ validator.String = blocksValidator{}
+
+// blocksValidator validates Block Kit JSON at plan time.
+type blocksValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v blocksValidator) Description(_ context.Context) string {
+	return "value must be a JSON array of Block Kit blocks"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v blocksValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v blocksValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := validateBlocks(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Block Kit Blocks", err.Error())
+	}
+}
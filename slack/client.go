@@ -0,0 +1,86 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/slack-go/slack"
+)
+
+// Client is the API client shared by the provider's data sources and resources.
+// It embeds the slack-go client and keeps the token around so that Web API
+// methods which slack-go does not implement can still be called.
+type Client struct {
+	*slack.Client
+
+	token      string
+	apiURL     string
+	httpClient *http.Client
+
+	// appConfigurationToken authenticates the apps.manifest.* methods, which
+	// do not accept bot or user tokens.
+	appConfigurationToken string
+}
+
+// NewClient creates a new Client authenticated with token.
+func NewClient(token string) *Client {
+	return &Client{
+		Client:     slack.New(token),
+		token:      token,
+		apiURL:     slack.APIURL,
+		httpClient: &http.Client{},
+	}
+}
+
+// apiResponse is implemented by every Web API response decoded by callMethod.
+type apiResponse interface {
+	Err() error
+}
+
+// callMethod posts values to the Web API method and decodes the JSON answer
+// into response. Errors returned by Slack are surfaced as slack.SlackErrorResponse
+// so they can be compared with their error code like slack-go errors.
+func (c *Client) callMethod(ctx context.Context, method string, values url.Values, response apiResponse) error {
+	return c.callMethodWithToken(ctx, c.token, method, values, response)
+}
+
+// callMethodWithToken is callMethod authenticated with token instead of the
+// provider token.
+func (c *Client) callMethodWithToken(ctx context.Context, token, method string, values url.Values, response apiResponse) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.apiURL+method, strings.NewReader(values.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		retry, err := strconv.ParseInt(resp.Header.Get("Retry-After"), 10, 64)
+		if err != nil {
+			return err
+		}
+		return &slack.RateLimitedError{RetryAfter: time.Duration(retry) * time.Second}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: unexpected HTTP status %s", method, resp.Status)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return fmt.Errorf("%s: unable to decode response: %s", method, err)
+	}
+
+	return response.Err()
+}
@@ -20,7 +20,7 @@ func NewConversationDataSource() datasource.DataSource {
 
 // ConversationDataSource implements the Slack conversation data source.
 type ConversationDataSource struct {
-	client *slack.Client
+	client *Client
 }
 
 // ConversationDataSourceModel describes the data source data model.
@@ -83,7 +83,7 @@ func (d *ConversationDataSource) Configure(_ context.Context, req datasource.Con
 		return
 	}
 
-	client, ok := req.ProviderData.(*slack.Client)
+	client, ok := req.ProviderData.(*Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
@@ -0,0 +1,227 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/slack-go/slack"
+)
+
+var _ datasource.DataSource = &ConversationMembersDataSource{}
+
+// NewConversationMembersDataSource creates a new Slack conversation members data source.
+func NewConversationMembersDataSource() datasource.DataSource {
+	return &ConversationMembersDataSource{}
+}
+
+// ConversationMembersDataSource implements the Slack conversation members data source.
+type ConversationMembersDataSource struct {
+	client *Client
+}
+
+// ConversationMembersDataSourceModel describes the data source data model.
+type ConversationMembersDataSourceModel struct {
+	ConversationID     types.String                        `tfsdk:"conversation_id"`
+	IncludeUserDetails types.Bool                          `tfsdk:"include_user_details"`
+	ExcludeBots        types.Bool                          `tfsdk:"exclude_bots"`
+	ExcludeDeleted     types.Bool                          `tfsdk:"exclude_deleted"`
+	Members            types.Set                           `tfsdk:"members"`
+	Users              []ConversationMembersDataSourceUser `tfsdk:"users"`
+}
+
+// ConversationMembersDataSourceUser describes a member returned with user details.
+type ConversationMembersDataSourceUser struct {
+	ID      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Email   types.String `tfsdk:"email"`
+	IsBot   types.Bool   `tfsdk:"is_bot"`
+	Deleted types.Bool   `tfsdk:"deleted"`
+}
+
+// Metadata returns the data source type name.
+func (d *ConversationMembersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_conversation_members"
+}
+
+// Schema defines the schema for the data source.
+func (d *ConversationMembersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the members of a Slack conversation",
+
+		Attributes: map[string]schema.Attribute{
+			"conversation_id": schema.StringAttribute{
+				MarkdownDescription: "The conversation ID to list the members of",
+				Required:            true,
+			},
+			"include_user_details": schema.BoolAttribute{
+				MarkdownDescription: "Whether to populate `users` with the attributes of each member. Default is false.",
+				Optional:            true,
+			},
+			"exclude_bots": schema.BoolAttribute{
+				MarkdownDescription: "Whether to leave bot users out of the results. Default is false.",
+				Optional:            true,
+			},
+			"exclude_deleted": schema.BoolAttribute{
+				MarkdownDescription: "Whether to leave deactivated users out of the results. Default is false.",
+				Optional:            true,
+			},
+			"members": schema.SetAttribute{
+				MarkdownDescription: "User IDs of the conversation members",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"users": schema.ListNestedAttribute{
+				MarkdownDescription: "Attributes of the conversation members, only set when `include_user_details` is true",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The user ID",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The username",
+							Computed:            true,
+						},
+						"email": schema.StringAttribute{
+							MarkdownDescription: "The user's email address",
+							Computed:            true,
+						},
+						"is_bot": schema.BoolAttribute{
+							MarkdownDescription: "Whether the user is a bot",
+							Computed:            true,
+						},
+						"deleted": schema.BoolAttribute{
+							MarkdownDescription: "Whether the user has been deactivated",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ConversationMembersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *slack.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ConversationMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ConversationMembersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := listConversationMembers(ctx, d.client, data.ConversationID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get users in conversation: %s", err))
+		return
+	}
+
+	includeDetails := data.IncludeUserDetails.ValueBool()
+	excludeBots := data.ExcludeBots.ValueBool()
+	excludeDeleted := data.ExcludeDeleted.ValueBool()
+
+	// User attributes are only needed to filter or to expose them, so the
+	// workspace users are listed once instead of looking up every member.
+	if includeDetails || excludeBots || excludeDeleted {
+		users, err := d.client.GetUsersContext(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace users: %s", err))
+			return
+		}
+
+		usersByID := make(map[string]slack.User, len(users))
+		for _, user := range users {
+			usersByID[user.ID] = user
+		}
+
+		filtered := []string{}
+		var details []ConversationMembersDataSourceUser
+		for _, member := range members {
+			user, ok := usersByID[member]
+			if !ok {
+				// Members from other workspaces of a shared channel are not listed.
+				user = slack.User{ID: member}
+			}
+			if excludeBots && user.IsBot {
+				continue
+			}
+			if excludeDeleted && user.Deleted {
+				continue
+			}
+
+			filtered = append(filtered, member)
+			if includeDetails {
+				details = append(details, ConversationMembersDataSourceUser{
+					ID:      types.StringValue(user.ID),
+					Name:    types.StringValue(user.Name),
+					Email:   types.StringValue(user.Profile.Email),
+					IsBot:   types.BoolValue(user.IsBot),
+					Deleted: types.BoolValue(user.Deleted),
+				})
+			}
+		}
+		members = filtered
+		data.Users = details
+	}
+
+	if includeDetails && data.Users == nil {
+		data.Users = []ConversationMembersDataSourceUser{}
+	}
+
+	memberSet, diags := types.SetValueFrom(ctx, types.StringType, members)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Members = memberSet
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listConversationMembers returns the IDs of every member of the conversation,
+// following the pagination cursor until all pages have been read.
+func listConversationMembers(ctx context.Context, client *Client, channelID string) ([]string, error) {
+	members := []string{}
+	cursor := ""
+	for {
+		page, nextCursor, err := client.GetUsersInConversationContext(ctx, &slack.GetUsersInConversationParameters{
+			ChannelID: channelID,
+			Cursor:    cursor,
+			Limit:     200,
+		})
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, page...)
+
+		cursor = nextCursor
+		if cursor == "" {
+			break
+		}
+	}
+
+	sort.Strings(members)
+	return members, nil
+}
@@ -0,0 +1,71 @@
+package slack
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/slack-go/slack"
+)
+
+func TestAccSlackConversationMembersDataSource_basic(t *testing.T) {
+	if os.Getenv("TF_ACC") != "1" {
+		t.Skip("Acceptance tests skipped unless env 'TF_ACC' is set to 1")
+		return
+	}
+	name := acctest.RandomWithPrefix(conversationNamePrefix)
+	dataSourceName := fmt.Sprintf("data.slack_conversation_members.%s", name)
+	members := []string{testUser00.id, testUser01.id}
+	createChannel := testAccSlackConversationWithMembers(name, members)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckConversationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckSlackConversationMembersDataSourceConfigNonExistent,
+				ExpectError: regexp.MustCompile(`channel_not_found`),
+			},
+			{
+				Config: testAccCheckSlackConversationMembersDataSourceConfig(createChannel),
+				Check: resource.ComposeTestCheckFunc(
+					// The bot creating the channel is a member too, but it is excluded.
+					resource.TestCheckResourceAttr(dataSourceName, "members.#", "2"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "members.*", testUser00.id),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "members.*", testUser01.id),
+					resource.TestCheckResourceAttr(dataSourceName, "users.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "users.*", map[string]string{
+						"id":      testUser00.id,
+						"email":   testUser00.email,
+						"is_bot":  "false",
+						"deleted": "false",
+					}),
+				),
+			},
+		},
+	})
+}
+
+const (
+	testAccCheckSlackConversationMembersDataSourceConfigNonExistent = `
+data slack_conversation_members test {
+ conversation_id = "non-existent"
+}
+`
+	testAccCheckSlackConversationMembersDataSourceConfigExistent = `
+data slack_conversation_members %s {
+  conversation_id      = slack_conversation.%s.id
+  include_user_details = true
+  exclude_bots         = true
+  exclude_deleted      = true
+}
+`
+)
+
+func testAccCheckSlackConversationMembersDataSourceConfig(channel slack.Channel) string {
+	return testAccSlackConversationConfig(channel) + fmt.Sprintf(testAccCheckSlackConversationMembersDataSourceConfigExistent, channel.Name, channel.Name)
+}
@@ -0,0 +1,120 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &EmojisDataSource{}
+
+// NewEmojisDataSource creates a new Slack emojis data source.
+func NewEmojisDataSource() datasource.DataSource {
+	return &EmojisDataSource{}
+}
+
+// EmojisDataSource implements the Slack emojis data source.
+type EmojisDataSource struct {
+	client *Client
+}
+
+// EmojisDataSourceModel describes the data source data model.
+type EmojisDataSourceModel struct {
+	ExcludeAliases types.Bool `tfsdk:"exclude_aliases"`
+	Names          types.Set  `tfsdk:"names"`
+	Emojis         types.Map  `tfsdk:"emojis"`
+}
+
+// Metadata returns the data source type name.
+func (d *EmojisDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_emojis"
+}
+
+// Schema defines the schema for the data source.
+func (d *EmojisDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the custom emojis of the Slack workspace",
+
+		Attributes: map[string]schema.Attribute{
+			"exclude_aliases": schema.BoolAttribute{
+				MarkdownDescription: "Whether to leave emoji aliases out of the results. Default is false.",
+				Optional:            true,
+			},
+			"names": schema.SetAttribute{
+				MarkdownDescription: "The names of the custom emojis",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"emojis": schema.MapAttribute{
+				MarkdownDescription: "The custom emojis, mapping each name to the image URL, or to `alias:<name>` for aliases",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *EmojisDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *slack.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *EmojisDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EmojisDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	emojis, err := d.client.GetEmojiContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list emojis: %s", err))
+		return
+	}
+
+	if emojis == nil {
+		emojis = map[string]string{}
+	}
+
+	names := []string{}
+	for name, value := range emojis {
+		if data.ExcludeAliases.ValueBool() && strings.HasPrefix(value, emojiAliasPrefix) {
+			delete(emojis, name)
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	nameSet, diags := types.SetValueFrom(ctx, types.StringType, names)
+	resp.Diagnostics.Append(diags...)
+	emojiMap, diags := types.MapValueFrom(ctx, types.StringType, emojis)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Names = nameSet
+	data.Emojis = emojiMap
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,36 @@
+package slack
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSlackEmojisDataSource_basic(t *testing.T) {
+	if os.Getenv("TF_ACC") != "1" {
+		t.Skip("Acceptance tests skipped unless env 'TF_ACC' is set to 1")
+		return
+	}
+	dataSourceName := "data.slack_emojis.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSlackEmojisDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "names.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "emojis.%"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckSlackEmojisDataSourceConfig = `
+data slack_emojis test {
+  exclude_aliases = true
+}
+`
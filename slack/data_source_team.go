@@ -0,0 +1,176 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/slack-go/slack"
+)
+
+var _ datasource.DataSource = &TeamDataSource{}
+
+// NewTeamDataSource creates a new Slack team data source.
+func NewTeamDataSource() datasource.DataSource {
+	return &TeamDataSource{}
+}
+
+// TeamDataSource implements the Slack team data source.
+type TeamDataSource struct {
+	client *Client
+}
+
+// TeamDataSourceModel describes the data source data model.
+type TeamDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Domain         types.String `tfsdk:"domain"`
+	EmailDomain    types.String `tfsdk:"email_domain"`
+	URL            types.String `tfsdk:"url"`
+	EnterpriseID   types.String `tfsdk:"enterprise_id"`
+	EnterpriseName types.String `tfsdk:"enterprise_name"`
+	Icon           types.Map    `tfsdk:"icon"`
+	UserID         types.String `tfsdk:"user_id"`
+	BotID          types.String `tfsdk:"bot_id"`
+}
+
+// teamInfoResponse is the team.info answer including the Enterprise Grid
+// fields that slack.TeamInfo does not decode.
+type teamInfoResponse struct {
+	Team struct {
+		slack.TeamInfo
+		EnterpriseID   string `json:"enterprise_id"`
+		EnterpriseName string `json:"enterprise_name"`
+	} `json:"team"`
+	slack.SlackResponse
+}
+
+// Metadata returns the data source type name.
+func (d *TeamDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team"
+}
+
+// Schema defines the schema for the data source.
+func (d *TeamDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches information about the Slack workspace the provider is authenticated against",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The team ID",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The team name",
+				Computed:            true,
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The team domain, as in `<domain>.slack.com`",
+				Computed:            true,
+			},
+			"email_domain": schema.StringAttribute{
+				MarkdownDescription: "The email domain allowed to sign up to the team",
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The team URL (e.g. `https://example.slack.com/`)",
+				Computed:            true,
+			},
+			"enterprise_id": schema.StringAttribute{
+				MarkdownDescription: "The Enterprise Grid organization ID, empty outside of Enterprise Grid",
+				Computed:            true,
+			},
+			"enterprise_name": schema.StringAttribute{
+				MarkdownDescription: "The Enterprise Grid organization name, empty outside of Enterprise Grid",
+				Computed:            true,
+			},
+			"icon": schema.MapAttribute{
+				MarkdownDescription: "URLs of the team icon keyed by size (e.g. `image_34`, `image_original`)",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user the provider token belongs to",
+				Computed:            true,
+			},
+			"bot_id": schema.StringAttribute{
+				MarkdownDescription: "The bot ID when the provider token is a bot token",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *TeamDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *slack.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TeamDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TeamDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	auth, err := d.client.AuthTestContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to identify the provider token: %s", err))
+		return
+	}
+
+	var info teamInfoResponse
+	if err := d.client.callMethod(ctx, "team.info", url.Values{}, &info); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team: %s", err))
+		return
+	}
+
+	// The icon map also carries non URL entries such as image_default.
+	icon := map[string]string{}
+	for key, value := range info.Team.Icon {
+		if s, ok := value.(string); ok {
+			icon[key] = s
+		}
+	}
+
+	iconMap, diags := types.MapValueFrom(ctx, types.StringType, icon)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	enterpriseID := info.Team.EnterpriseID
+	if enterpriseID == "" {
+		enterpriseID = auth.EnterpriseID
+	}
+
+	data.ID = types.StringValue(info.Team.ID)
+	data.Name = types.StringValue(info.Team.Name)
+	data.Domain = types.StringValue(info.Team.Domain)
+	data.EmailDomain = types.StringValue(info.Team.EmailDomain)
+	data.URL = types.StringValue(auth.URL)
+	data.EnterpriseID = types.StringValue(enterpriseID)
+	data.EnterpriseName = types.StringValue(info.Team.EnterpriseName)
+	data.Icon = iconMap
+	data.UserID = types.StringValue(auth.UserID)
+	data.BotID = types.StringValue(auth.BotID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
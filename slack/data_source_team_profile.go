@@ -0,0 +1,264 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/slack-go/slack"
+)
+
+var _ datasource.DataSource = &TeamProfileDataSource{}
+
+// NewTeamProfileDataSource creates a new Slack team profile data source.
+func NewTeamProfileDataSource() datasource.DataSource {
+	return &TeamProfileDataSource{}
+}
+
+// TeamProfileDataSource implements the Slack team profile data source.
+type TeamProfileDataSource struct {
+	client *Client
+}
+
+// TeamProfileDataSourceModel describes the data source data model.
+type TeamProfileDataSourceModel struct {
+	IncludeHidden types.Bool                     `tfsdk:"include_hidden"`
+	Sections      []TeamProfileDataSourceSection `tfsdk:"sections"`
+	Fields        []TeamProfileDataSourceField   `tfsdk:"fields"`
+}
+
+// TeamProfileDataSourceSection describes a section of the team profile.
+type TeamProfileDataSourceSection struct {
+	ID       types.String `tfsdk:"id"`
+	Label    types.String `tfsdk:"label"`
+	Type     types.String `tfsdk:"type"`
+	Order    types.Int64  `tfsdk:"order"`
+	IsHidden types.Bool   `tfsdk:"is_hidden"`
+}
+
+// TeamProfileDataSourceField describes a field of the team profile.
+type TeamProfileDataSourceField struct {
+	ID        types.String `tfsdk:"id"`
+	Label     types.String `tfsdk:"label"`
+	Hint      types.String `tfsdk:"hint"`
+	Type      types.String `tfsdk:"type"`
+	Options   types.List   `tfsdk:"options"`
+	Ordering  types.Int64  `tfsdk:"ordering"`
+	IsHidden  types.Bool   `tfsdk:"is_hidden"`
+	SectionID types.String `tfsdk:"section_id"`
+}
+
+// teamProfileSection is a section of the team profile, which slack-go does
+// not decode.
+type teamProfileSection struct {
+	ID          string `json:"id"`
+	Label       string `json:"label"`
+	SectionType string `json:"section_type"`
+	Order       int    `json:"order"`
+	IsHidden    bool   `json:"is_hidden"`
+}
+
+// teamProfileField is a field of the team profile along with its section.
+type teamProfileField struct {
+	slack.TeamProfileField
+	SectionID string `json:"section_id"`
+}
+
+// teamProfileResponse is the team.profile.get answer.
+type teamProfileResponse struct {
+	Profile struct {
+		Fields   []teamProfileField   `json:"fields"`
+		Sections []teamProfileSection `json:"sections"`
+	} `json:"profile"`
+	slack.SlackResponse
+}
+
+// Metadata returns the data source type name.
+func (d *TeamProfileDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_profile"
+}
+
+// Schema defines the schema for the data source.
+func (d *TeamProfileDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the sections and fields of the profiles of the Slack workspace",
+
+		Attributes: map[string]schema.Attribute{
+			"include_hidden": schema.BoolAttribute{
+				MarkdownDescription: "Whether to include the hidden sections and fields. Default is false.",
+				Optional:            true,
+			},
+			"sections": schema.ListNestedAttribute{
+				MarkdownDescription: "The sections of the profile, sorted by order",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The section ID",
+							Computed:            true,
+						},
+						"label": schema.StringAttribute{
+							MarkdownDescription: "The label of the section",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the section",
+							Computed:            true,
+						},
+						"order": schema.Int64Attribute{
+							MarkdownDescription: "The position of the section in the profile",
+							Computed:            true,
+						},
+						"is_hidden": schema.BoolAttribute{
+							MarkdownDescription: "Whether the section is hidden",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"fields": schema.ListNestedAttribute{
+				MarkdownDescription: "The fields of the profile, sorted by ordering",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The field ID",
+							Computed:            true,
+						},
+						"label": schema.StringAttribute{
+							MarkdownDescription: "The label of the field",
+							Computed:            true,
+						},
+						"hint": schema.StringAttribute{
+							MarkdownDescription: "The hint displayed below the field",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the field",
+							Computed:            true,
+						},
+						"options": schema.ListAttribute{
+							MarkdownDescription: "The values allowed for an `options_list` field",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"ordering": schema.Int64Attribute{
+							MarkdownDescription: "The position of the field in the profile",
+							Computed:            true,
+						},
+						"is_hidden": schema.BoolAttribute{
+							MarkdownDescription: "Whether the field is hidden",
+							Computed:            true,
+						},
+						"section_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the section the field belongs to",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *TeamProfileDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *slack.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TeamProfileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TeamProfileDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err := getTeamProfile(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team profile: %s", err))
+		return
+	}
+
+	includeHidden := data.IncludeHidden.ValueBool()
+
+	sections := profile.Profile.Sections
+	sort.SliceStable(sections, func(i, j int) bool { return sections[i].Order < sections[j].Order })
+	data.Sections = []TeamProfileDataSourceSection{}
+	for _, section := range sections {
+		if section.IsHidden && !includeHidden {
+			continue
+		}
+		data.Sections = append(data.Sections, TeamProfileDataSourceSection{
+			ID:       types.StringValue(section.ID),
+			Label:    types.StringValue(section.Label),
+			Type:     types.StringValue(section.SectionType),
+			Order:    types.Int64Value(int64(section.Order)),
+			IsHidden: types.BoolValue(section.IsHidden),
+		})
+	}
+
+	fields := profile.Profile.Fields
+	sort.SliceStable(fields, func(i, j int) bool { return fields[i].Ordering < fields[j].Ordering })
+	data.Fields = []TeamProfileDataSourceField{}
+	for _, field := range fields {
+		if field.IsHidden && !includeHidden {
+			continue
+		}
+
+		options, diags := types.ListValueFrom(ctx, types.StringType, nonNilStrings(field.PossibleValues))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.Fields = append(data.Fields, TeamProfileDataSourceField{
+			ID:        types.StringValue(field.ID),
+			Label:     types.StringValue(field.Label),
+			Hint:      types.StringValue(field.Hint),
+			Type:      types.StringValue(field.Type),
+			Options:   options,
+			Ordering:  types.Int64Value(int64(field.Ordering)),
+			IsHidden:  types.BoolValue(field.IsHidden),
+			SectionID: types.StringValue(field.SectionID),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// getTeamProfile returns every section and field of the team profile,
+// including the hidden ones.
+func getTeamProfile(ctx context.Context, client *Client) (*teamProfileResponse, error) {
+	var profile teamProfileResponse
+	if err := client.callMethod(ctx, "team.profile.get", url.Values{"visibility": {"all"}}, &profile); err != nil {
+		return nil, err
+	}
+
+	return &profile, nil
+}
+
+// nonNilStrings returns values, or an empty slice when values is nil.
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
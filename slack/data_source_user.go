@@ -22,14 +22,32 @@ func NewUserDataSource() datasource.DataSource {
 
 // UserDataSource implements the Slack user data source.
 type UserDataSource struct {
-	client *slack.Client
+	client *Client
 }
 
 // UserDataSourceModel describes the data source data model.
 type UserDataSourceModel struct {
-	ID    types.String `tfsdk:"id"`
-	Name  types.String `tfsdk:"name"`
-	Email types.String `tfsdk:"email"`
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Email             types.String `tfsdk:"email"`
+	DisplayName       types.String `tfsdk:"display_name"`
+	RealName          types.String `tfsdk:"real_name"`
+	Title             types.String `tfsdk:"title"`
+	TZ                types.String `tfsdk:"tz"`
+	TeamID            types.String `tfsdk:"team_id"`
+	IsBot             types.Bool   `tfsdk:"is_bot"`
+	IsAdmin           types.Bool   `tfsdk:"is_admin"`
+	IsOwner           types.Bool   `tfsdk:"is_owner"`
+	IsRestricted      types.Bool   `tfsdk:"is_restricted"`
+	IsUltraRestricted types.Bool   `tfsdk:"is_ultra_restricted"`
+	Deleted           types.Bool   `tfsdk:"deleted"`
+	Image24           types.String `tfsdk:"image_24"`
+	Image32           types.String `tfsdk:"image_32"`
+	Image48           types.String `tfsdk:"image_48"`
+	Image72           types.String `tfsdk:"image_72"`
+	Image192          types.String `tfsdk:"image_192"`
+	Image512          types.String `tfsdk:"image_512"`
+	ImageOriginal     types.String `tfsdk:"image_original"`
 }
 
 // Metadata returns the data source type name.
@@ -40,19 +58,24 @@ func (d *UserDataSource) Metadata(_ context.Context, req datasource.MetadataRequ
 // Schema defines the schema for the data source.
 func (d *UserDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Fetches information about a Slack user",
+		MarkdownDescription: "Fetches information about a Slack user. Exactly one of `id`, `name`, `email`, `display_name` or `real_name` must be specified.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				MarkdownDescription: "The user ID",
+				MarkdownDescription: "The user ID to look up or the computed user ID",
+				Optional:            true,
 				Computed:            true,
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "The username",
 				Optional:            true,
+				Computed:            true,
 				Validators: []validator.String{
 					stringvalidator.ExactlyOneOf(path.Expressions{
+						path.MatchRoot("id"),
 						path.MatchRoot("email"),
+						path.MatchRoot("display_name"),
+						path.MatchRoot("real_name"),
 					}...),
 				},
 			},
@@ -61,6 +84,80 @@ func (d *UserDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 				Optional:            true,
 				Computed:            true,
 			},
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "The user's display name",
+				Optional:            true,
+				Computed:            true,
+			},
+			"real_name": schema.StringAttribute{
+				MarkdownDescription: "The user's real name",
+				Optional:            true,
+				Computed:            true,
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "The user's title",
+				Computed:            true,
+			},
+			"tz": schema.StringAttribute{
+				MarkdownDescription: "The user's time zone (e.g. `Europe/Paris`)",
+				Computed:            true,
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workspace the user belongs to",
+				Computed:            true,
+			},
+			"is_bot": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is a bot",
+				Computed:            true,
+			},
+			"is_admin": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is a workspace admin",
+				Computed:            true,
+			},
+			"is_owner": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is a workspace owner",
+				Computed:            true,
+			},
+			"is_restricted": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is a multi-channel guest",
+				Computed:            true,
+			},
+			"is_ultra_restricted": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is a single-channel guest",
+				Computed:            true,
+			},
+			"deleted": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user has been deactivated",
+				Computed:            true,
+			},
+			"image_24": schema.StringAttribute{
+				MarkdownDescription: "URL of the user's 24x24 profile image",
+				Computed:            true,
+			},
+			"image_32": schema.StringAttribute{
+				MarkdownDescription: "URL of the user's 32x32 profile image",
+				Computed:            true,
+			},
+			"image_48": schema.StringAttribute{
+				MarkdownDescription: "URL of the user's 48x48 profile image",
+				Computed:            true,
+			},
+			"image_72": schema.StringAttribute{
+				MarkdownDescription: "URL of the user's 72x72 profile image",
+				Computed:            true,
+			},
+			"image_192": schema.StringAttribute{
+				MarkdownDescription: "URL of the user's 192x192 profile image",
+				Computed:            true,
+			},
+			"image_512": schema.StringAttribute{
+				MarkdownDescription: "URL of the user's 512x512 profile image",
+				Computed:            true,
+			},
+			"image_original": schema.StringAttribute{
+				MarkdownDescription: "URL of the user's original profile image",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -71,7 +168,7 @@ func (d *UserDataSource) Configure(_ context.Context, req datasource.ConfigureRe
 		return
 	}
 
-	client, ok := req.ProviderData.(*slack.Client)
+	client, ok := req.ProviderData.(*Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
@@ -94,18 +191,37 @@ func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	var user *slack.User
 	var err error
 
-	if !data.Name.IsNull() {
-		user, err = d.searchByName(ctx, data.Name.ValueString())
+	switch {
+	case !data.ID.IsNull():
+		user, err = d.client.GetUserInfoContext(ctx, data.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find user by ID: %s", err))
+			return
+		}
+	case !data.Name.IsNull():
+		user, err = d.searchUsers(ctx, "name", data.Name.ValueString(), func(u slack.User) string { return u.Name })
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find user by name: %s", err))
 			return
 		}
-	} else if !data.Email.IsNull() {
+	case !data.Email.IsNull():
 		user, err = d.client.GetUserByEmailContext(ctx, data.Email.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find user by email: %s", err))
 			return
 		}
+	case !data.DisplayName.IsNull():
+		user, err = d.searchUsers(ctx, "display_name", data.DisplayName.ValueString(), func(u slack.User) string { return u.Profile.DisplayName })
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find user by display name: %s", err))
+			return
+		}
+	case !data.RealName.IsNull():
+		user, err = d.searchUsers(ctx, "real_name", data.RealName.ValueString(), func(u slack.User) string { return u.RealName })
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find user by real name: %s", err))
+			return
+		}
 	}
 
 	if user == nil {
@@ -116,11 +232,31 @@ func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	data.ID = types.StringValue(user.ID)
 	data.Name = types.StringValue(user.Name)
 	data.Email = types.StringValue(user.Profile.Email)
+	data.DisplayName = types.StringValue(user.Profile.DisplayName)
+	data.RealName = types.StringValue(user.RealName)
+	data.Title = types.StringValue(user.Profile.Title)
+	data.TZ = types.StringValue(user.TZ)
+	data.TeamID = types.StringValue(user.TeamID)
+	data.IsBot = types.BoolValue(user.IsBot)
+	data.IsAdmin = types.BoolValue(user.IsAdmin)
+	data.IsOwner = types.BoolValue(user.IsOwner)
+	data.IsRestricted = types.BoolValue(user.IsRestricted)
+	data.IsUltraRestricted = types.BoolValue(user.IsUltraRestricted)
+	data.Deleted = types.BoolValue(user.Deleted)
+	data.Image24 = types.StringValue(user.Profile.Image24)
+	data.Image32 = types.StringValue(user.Profile.Image32)
+	data.Image48 = types.StringValue(user.Profile.Image48)
+	data.Image72 = types.StringValue(user.Profile.Image72)
+	data.Image192 = types.StringValue(user.Profile.Image192)
+	data.Image512 = types.StringValue(user.Profile.Image512)
+	data.ImageOriginal = types.StringValue(user.Profile.ImageOriginal)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (d *UserDataSource) searchByName(ctx context.Context, name string) (*slack.User, error) {
+// searchUsers lists the workspace users and returns the single user whose
+// attribute, as extracted by field, equals value.
+func (d *UserDataSource) searchUsers(ctx context.Context, attribute, value string, field func(slack.User) string) (*slack.User, error) {
 	users, err := d.client.GetUsersContext(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't get workspace users: %s", err)
@@ -128,17 +264,17 @@ func (d *UserDataSource) searchByName(ctx context.Context, name string) (*slack.
 
 	var matchingUsers []slack.User
 	for _, user := range users {
-		if user.Name == name {
+		if field(user) == value {
 			matchingUsers = append(matchingUsers, user)
 		}
 	}
 
 	if len(matchingUsers) < 1 {
-		return nil, fmt.Errorf("no results found for name %s", name)
+		return nil, fmt.Errorf("no results found for %s %s", attribute, value)
 	}
 
 	if len(matchingUsers) > 1 {
-		return nil, fmt.Errorf("multiple results found for name %s", name)
+		return nil, fmt.Errorf("multiple results found for %s %s", attribute, value)
 	}
 
 	return &matchingUsers[0], nil
@@ -44,6 +44,32 @@ func TestAccSlackUserDataSource_basic(t *testing.T) {
 		})
 	})
 
+	t.Run("search non-existent user by display name", func(t *testing.T) {
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config:      testAccCheckSlackUserDataSourceConfigNonExistentByDisplayName,
+					ExpectError: regexp.MustCompile(`no results found for display_name`),
+				},
+			},
+		})
+	})
+
+	t.Run("search non-existent user by ID", func(t *testing.T) {
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config:      testAccCheckSlackUserDataSourceConfigNonExistentByID,
+					ExpectError: regexp.MustCompile(`user_not_found`),
+				},
+			},
+		})
+	})
+
 	t.Run("search without setting any field", func(t *testing.T) {
 		resource.ParallelTest(t, resource.TestCase{
 			PreCheck:                 func() { testAccPreCheck(t) },
@@ -88,6 +114,27 @@ func TestAccSlackUserDataSource_basic(t *testing.T) {
 		})
 	})
 
+	t.Run("search by ID", func(t *testing.T) {
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccCheckSlackUserDataSourceConfigExistentByID(),
+					Check: resource.ComposeTestCheckFunc(
+						testAccCheckSlackUserDataSourceID(dataSourceName),
+						resource.TestCheckResourceAttr(dataSourceName, "name", testUser00.name),
+						resource.TestCheckResourceAttr(dataSourceName, "id", testUser00.id),
+						resource.TestCheckResourceAttr(dataSourceName, "email", testUser00.email),
+						resource.TestCheckResourceAttr(dataSourceName, "deleted", "false"),
+						resource.TestCheckResourceAttr(dataSourceName, "is_bot", "false"),
+						resource.TestCheckResourceAttrSet(dataSourceName, "team_id"),
+					),
+				},
+			},
+		})
+	})
+
 	t.Run("search by email", func(t *testing.T) {
 		resource.ParallelTest(t, resource.TestCase{
 			PreCheck:                 func() { testAccPreCheck(t) },
@@ -132,6 +179,18 @@ data slack_user test {
 data slack_user test {
  email = "non-existent"
 }
+`
+
+	testAccCheckSlackUserDataSourceConfigNonExistentByDisplayName = `
+data slack_user test {
+ display_name = "non-existent"
+}
+`
+
+	testAccCheckSlackUserDataSourceConfigNonExistentByID = `
+data slack_user test {
+ id = "U00000000"
+}
 `
 
 	testAccCheckSlackUserDataSourceConfigMissingFields = `
@@ -148,6 +207,14 @@ data slack_user test {
 `, testUser00.name)
 }
 
+func testAccCheckSlackUserDataSourceConfigExistentByID() string {
+	return fmt.Sprintf(`
+data slack_user test {
+ id = "%s"
+}
+`, testUser00.id)
+}
+
 func testAccCheckSlackUserDataSourceConfigExistentByEmail() string {
 	return fmt.Sprintf(`
 data slack_user test {
@@ -19,7 +19,7 @@ func NewUsergroupDataSource() datasource.DataSource {
 
 // UsergroupDataSource implements the Slack usergroup data source.
 type UsergroupDataSource struct {
-	client *slack.Client
+	client *Client
 }
 
 // UsergroupDataSourceModel describes the data source data model.
@@ -86,7 +86,7 @@ func (d *UsergroupDataSource) Configure(_ context.Context, req datasource.Config
 		return
 	}
 
-	client, ok := req.ProviderData.(*slack.Client)
+	client, ok := req.ProviderData.(*Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
@@ -0,0 +1,204 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/slack-go/slack"
+)
+
+var _ datasource.DataSource = &UsergroupsDataSource{}
+
+// NewUsergroupsDataSource creates a new Slack usergroups data source.
+func NewUsergroupsDataSource() datasource.DataSource {
+	return &UsergroupsDataSource{}
+}
+
+// UsergroupsDataSource implements the Slack usergroups data source.
+type UsergroupsDataSource struct {
+	client *Client
+}
+
+// UsergroupsDataSourceModel describes the data source data model.
+type UsergroupsDataSourceModel struct {
+	HandlePrefix    types.String                  `tfsdk:"handle_prefix"`
+	IncludeDisabled types.Bool                    `tfsdk:"include_disabled"`
+	IncludeCount    types.Bool                    `tfsdk:"include_count"`
+	IncludeUsers    types.Bool                    `tfsdk:"include_users"`
+	Usergroups      []UsergroupsDataSourceElement `tfsdk:"usergroups"`
+}
+
+// UsergroupsDataSourceElement describes a single usergroup returned by the data source.
+type UsergroupsDataSourceElement struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Handle      types.String `tfsdk:"handle"`
+	Description types.String `tfsdk:"description"`
+	IsDisabled  types.Bool   `tfsdk:"is_disabled"`
+	IsExternal  types.Bool   `tfsdk:"is_external"`
+	UserCount   types.Int64  `tfsdk:"user_count"`
+	Users       types.Set    `tfsdk:"users"`
+	Channels    types.Set    `tfsdk:"channels"`
+}
+
+// Metadata returns the data source type name.
+func (d *UsergroupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_usergroups"
+}
+
+// Schema defines the schema for the data source.
+func (d *UsergroupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches all the usergroups of the Slack workspace",
+
+		Attributes: map[string]schema.Attribute{
+			"handle_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only return usergroups whose handle starts with this prefix",
+				Optional:            true,
+			},
+			"include_disabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether to include disabled usergroups. Default is false.",
+				Optional:            true,
+			},
+			"include_count": schema.BoolAttribute{
+				MarkdownDescription: "Whether to include the number of users in each usergroup. Default is false.",
+				Optional:            true,
+			},
+			"include_users": schema.BoolAttribute{
+				MarkdownDescription: "Whether to include the user IDs of each usergroup. Default is false.",
+				Optional:            true,
+			},
+			"usergroups": schema.ListNestedAttribute{
+				MarkdownDescription: "The usergroups matching the filters",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The usergroup ID",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The usergroup name",
+							Computed:            true,
+						},
+						"handle": schema.StringAttribute{
+							MarkdownDescription: "The usergroup handle",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The usergroup description",
+							Computed:            true,
+						},
+						"is_disabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether the usergroup is disabled",
+							Computed:            true,
+						},
+						"is_external": schema.BoolAttribute{
+							MarkdownDescription: "Whether the usergroup belongs to an external organization",
+							Computed:            true,
+						},
+						"user_count": schema.Int64Attribute{
+							MarkdownDescription: "Number of users in the usergroup, only set when `include_count` is true",
+							Computed:            true,
+						},
+						"users": schema.SetAttribute{
+							MarkdownDescription: "User IDs that are members of the usergroup, only set when `include_users` is true",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"channels": schema.SetAttribute{
+							MarkdownDescription: "Channel IDs that the usergroup is associated with",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *UsergroupsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *slack.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UsergroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UsergroupsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	includeCount := data.IncludeCount.ValueBool()
+	includeUsers := data.IncludeUsers.ValueBool()
+
+	userGroups, err := d.client.GetUserGroupsContext(ctx,
+		slack.GetUserGroupsOptionIncludeDisabled(data.IncludeDisabled.ValueBool()),
+		slack.GetUserGroupsOptionIncludeCount(includeCount),
+		slack.GetUserGroupsOptionIncludeUsers(includeUsers),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read usergroups: %s", err))
+		return
+	}
+
+	prefix := data.HandlePrefix.ValueString()
+	data.Usergroups = []UsergroupsDataSourceElement{}
+	for _, ug := range userGroups {
+		if !strings.HasPrefix(ug.Handle, prefix) {
+			continue
+		}
+
+		element := UsergroupsDataSourceElement{
+			ID:          types.StringValue(ug.ID),
+			Name:        types.StringValue(ug.Name),
+			Handle:      types.StringValue(ug.Handle),
+			Description: types.StringValue(ug.Description),
+			IsDisabled:  types.BoolValue(ug.DateDelete != 0),
+			IsExternal:  types.BoolValue(ug.IsExternal),
+			UserCount:   types.Int64Null(),
+			Users:       types.SetNull(types.StringType),
+		}
+
+		if includeCount {
+			element.UserCount = types.Int64Value(int64(ug.UserCount))
+		}
+
+		if includeUsers {
+			userSet, diags := types.SetValueFrom(ctx, types.StringType, ug.Users)
+			resp.Diagnostics.Append(diags...)
+			element.Users = userSet
+		}
+
+		channelSet, diags := types.SetValueFrom(ctx, types.StringType, ug.Prefs.Channels)
+		resp.Diagnostics.Append(diags...)
+		element.Channels = channelSet
+
+		data.Usergroups = append(data.Usergroups, element)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
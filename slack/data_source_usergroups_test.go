@@ -0,0 +1,57 @@
+package slack
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/slack-go/slack"
+)
+
+func TestAccSlackUserGroupsDataSource_basic(t *testing.T) {
+	if os.Getenv("TF_ACC") != "1" {
+		t.Skip("Acceptance tests skipped unless env 'TF_ACC' is set to 1")
+		return
+	}
+	resourceName := "slack_usergroup.test"
+	dataSourceName := "data.slack_usergroups.test"
+
+	t.Run("filter by handle prefix", func(t *testing.T) {
+		name := acctest.RandomWithPrefix(userGroupResourceNamePrefix)
+		users := []string{testUser00.id, testUser01.id}
+		createUserGroup := testAccSlackUserGroupWithUsers(name, []string{}, users)
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			CheckDestroy:             testAccCheckUserGroupDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccCheckSlackUserGroupsDataSourceConfig(createUserGroup),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(dataSourceName, "usergroups.#", "1"),
+						resource.TestCheckResourceAttrPair(dataSourceName, "usergroups.0.id", resourceName, "id"),
+						resource.TestCheckResourceAttrPair(dataSourceName, "usergroups.0.name", resourceName, "name"),
+						resource.TestCheckResourceAttrPair(dataSourceName, "usergroups.0.handle", resourceName, "handle"),
+						resource.TestCheckResourceAttrPair(dataSourceName, "usergroups.0.description", resourceName, "description"),
+						resource.TestCheckResourceAttrPair(dataSourceName, "usergroups.0.users", resourceName, "users"),
+						resource.TestCheckResourceAttr(dataSourceName, "usergroups.0.user_count", "2"),
+						resource.TestCheckResourceAttr(dataSourceName, "usergroups.0.is_disabled", "false"),
+					),
+				},
+			},
+		})
+	})
+}
+
+const testAccCheckSlackUserGroupsDataSourceConfigExistent = `
+data slack_usergroups test {
+  handle_prefix = slack_usergroup.test.handle
+  include_count = true
+  include_users = true
+}
+`
+
+func testAccCheckSlackUserGroupsDataSourceConfig(group slack.UserGroup) string {
+	return testAccSlackUserGroupConfig(group) + testAccCheckSlackUserGroupsDataSourceConfigExistent
+}
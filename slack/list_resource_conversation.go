@@ -0,0 +1,175 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/slack-go/slack"
+)
+
+var _ list.ListResource = &ConversationListResource{}
+var _ list.ListResourceWithConfigure = &ConversationListResource{}
+
+// NewConversationListResource creates a new Slack conversation list resource.
+func NewConversationListResource() list.ListResource {
+	return &ConversationListResource{}
+}
+
+// ConversationListResource lists the Slack conversations which can be
+// imported as slack_conversation resources.
+type ConversationListResource struct {
+	client *Client
+}
+
+// ConversationListResourceModel describes the conversation list resource filters.
+type ConversationListResourceModel struct {
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	IsPrivate  types.Bool   `tfsdk:"is_private"`
+	IsArchived types.Bool   `tfsdk:"is_archived"`
+}
+
+// Metadata returns the name of the listed resource type.
+func (r *ConversationListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_conversation"
+}
+
+// ListResourceConfigSchema defines the filters of the list resource.
+func (r *ConversationListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the Slack conversations (channels) visible to the token",
+
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only list conversations whose name starts with this prefix",
+				Optional:            true,
+			},
+			"is_private": schema.BoolAttribute{
+				MarkdownDescription: "Only list private conversations when true, or public ones when false. Both are listed if not set.",
+				Optional:            true,
+			},
+			"is_archived": schema.BoolAttribute{
+				MarkdownDescription: "Only list archived conversations when true, or unarchived ones when false. Both are listed if not set.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the list resource.
+func (r *ConversationListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *slack.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// List streams the conversations matching the filters.
+func (r *ConversationListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var filters ConversationListResourceModel
+
+	diags := req.Config.Get(ctx, &filters)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	channelTypes := []string{"public_channel", "private_channel"}
+	if !filters.IsPrivate.IsNull() {
+		channelTypes = []string{"public_channel"}
+		if filters.IsPrivate.ValueBool() {
+			channelTypes = []string{"private_channel"}
+		}
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var listed int64
+		cursor := ""
+		for {
+			channels, nextCursor, err := r.client.GetConversationsContext(ctx, &slack.GetConversationsParameters{
+				Cursor:          cursor,
+				ExcludeArchived: !filters.IsArchived.IsNull() && !filters.IsArchived.ValueBool(),
+				Limit:           1000,
+				Types:           channelTypes,
+			})
+			if err != nil {
+				result := req.NewListResult(ctx)
+				result.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list conversations: %s", err))
+				push(result)
+				return
+			}
+
+			for _, channel := range channels {
+				if !strings.HasPrefix(channel.Name, filters.NamePrefix.ValueString()) {
+					continue
+				}
+				if !filters.IsArchived.IsNull() && channel.IsArchived != filters.IsArchived.ValueBool() {
+					continue
+				}
+
+				if !push(r.listResult(ctx, req, channel)) {
+					return
+				}
+				listed++
+				if req.Limit > 0 && listed >= req.Limit {
+					return
+				}
+			}
+
+			cursor = nextCursor
+			if cursor == "" {
+				return
+			}
+		}
+	}
+}
+
+// listResult returns the identity of the conversation, and its state when
+// Terraform asks for it.
+func (r *ConversationListResource) listResult(ctx context.Context, req list.ListRequest, channel slack.Channel) list.ListResult {
+	result := req.NewListResult(ctx)
+	result.DisplayName = "#" + channel.Name
+
+	result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("id"), channel.ID)...)
+	if !req.IncludeResource {
+		return result
+	}
+
+	// The arguments only known to Terraform get their default values
+	data := ConversationResourceModel{
+		ID:                             types.StringValue(channel.ID),
+		Name:                           types.StringValue(channel.Name),
+		Topic:                          types.StringValue(channel.Topic.Value),
+		Purpose:                        types.StringValue(channel.Purpose.Value),
+		PermanentMembers:               types.SetNull(types.StringType),
+		Created:                        types.Int64Value(int64(channel.Created)),
+		Creator:                        types.StringValue(channel.Creator),
+		IsPrivate:                      types.BoolValue(channel.IsPrivate),
+		IsArchived:                     types.BoolValue(channel.IsArchived),
+		IsShared:                       types.BoolValue(channel.IsShared),
+		IsExtShared:                    types.BoolValue(channel.IsExtShared),
+		IsOrgShared:                    types.BoolValue(channel.IsOrgShared),
+		IsGeneral:                      types.BoolValue(channel.IsGeneral),
+		ActionOnDestroy:                types.StringValue("archive"),
+		ActionOnUpdatePermanentMembers: types.StringValue("kick"),
+		AdoptExistingChannel:           types.BoolValue(false),
+	}
+	result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+
+	return result
+}
@@ -0,0 +1,116 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccSlackConversationListResourceTest(t *testing.T) {
+	if os.Getenv("TF_ACC") != "1" {
+		t.Skip("Acceptance tests skipped unless env 'TF_ACC' is set to 1")
+		return
+	}
+
+	name := acctest.RandomWithPrefix(conversationNamePrefix)
+	resourceName := "slack_conversation." + name
+	channel := testAccSlackConversation(name)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckConversationDestroy,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackConversationConfig(channel),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("id")),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckListed(NewConversationListResource(), NewConversationResource(), resourceName, map[string]tftypes.Value{
+						"name_prefix": tftypes.NewValue(tftypes.String, name),
+						"is_private":  tftypes.NewValue(tftypes.Bool, true),
+						"is_archived": tftypes.NewValue(tftypes.Bool, false),
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateKind:         resource.ImportBlockWithResourceIdentity,
+				ImportStateVerifyIgnore: []string{"permanent_members", "action_on_destroy", "action_on_update_permanent_members", "adopt_existing_channel"},
+			},
+		},
+	})
+}
+
+// testAccCheckListed runs the list resource with the given filters and checks
+// that it lists the resource, with the same ID in its identity and state.
+func testAccCheckListed(lr list.ListResource, r fwresource.Resource, resourceName string, filters map[string]tftypes.Value) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		ctx := context.Background()
+		lr.(list.ListResourceWithConfigure).Configure(ctx, fwresource.ConfigureRequest{ProviderData: NewClient(os.Getenv("SLACK_TOKEN"))}, &fwresource.ConfigureResponse{})
+
+		var listSchema list.ListResourceSchemaResponse
+		lr.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &listSchema)
+		var resourceSchema fwresource.SchemaResponse
+		r.Schema(ctx, fwresource.SchemaRequest{}, &resourceSchema)
+		var identitySchema fwresource.IdentitySchemaResponse
+		r.(fwresource.ResourceWithIdentity).IdentitySchema(ctx, fwresource.IdentitySchemaRequest{}, &identitySchema)
+
+		var stream list.ListResultsStream
+		lr.List(ctx, list.ListRequest{
+			Config: tfsdk.Config{
+				Schema: listSchema.Schema,
+				Raw:    tftypes.NewValue(listSchema.Schema.Type().TerraformType(ctx), filters),
+			},
+			IncludeResource:        true,
+			ResourceSchema:         resourceSchema.Schema,
+			ResourceIdentitySchema: identitySchema.IdentitySchema,
+		}, &stream)
+
+		for result := range stream.Results {
+			if result.Diagnostics.HasError() {
+				return fmt.Errorf("error listing %s: %v", resourceName, result.Diagnostics)
+			}
+
+			var identityID, stateID string
+			if diags := result.Identity.GetAttribute(ctx, path.Root("id"), &identityID); diags.HasError() {
+				return fmt.Errorf("error reading listed identity: %v", diags)
+			}
+			if identityID != rs.Primary.ID {
+				continue
+			}
+			if diags := result.Resource.GetAttribute(ctx, path.Root("id"), &stateID); diags.HasError() {
+				return fmt.Errorf("error reading listed state: %v", diags)
+			}
+			if stateID != identityID {
+				return fmt.Errorf("listed state ID %s does not match identity ID %s", stateID, identityID)
+			}
+			return nil
+		}
+
+		return fmt.Errorf("%s (%s) was not listed", resourceName, rs.Primary.ID)
+	}
+}
@@ -0,0 +1,140 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/slack-go/slack"
+)
+
+var _ list.ListResource = &UsergroupListResource{}
+var _ list.ListResourceWithConfigure = &UsergroupListResource{}
+
+// NewUsergroupListResource creates a new Slack usergroup list resource.
+func NewUsergroupListResource() list.ListResource {
+	return &UsergroupListResource{}
+}
+
+// UsergroupListResource lists the Slack usergroups which can be imported as
+// slack_usergroup resources.
+type UsergroupListResource struct {
+	client *Client
+}
+
+// UsergroupListResourceModel describes the usergroup list resource filters.
+type UsergroupListResourceModel struct {
+	NamePrefix   types.String `tfsdk:"name_prefix"`
+	HandlePrefix types.String `tfsdk:"handle_prefix"`
+}
+
+// Metadata returns the name of the listed resource type.
+func (r *UsergroupListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_usergroup"
+}
+
+// ListResourceConfigSchema defines the filters of the list resource.
+func (r *UsergroupListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the enabled usergroups of the Slack workspace",
+
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only list usergroups whose name starts with this prefix",
+				Optional:            true,
+			},
+			"handle_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only list usergroups whose handle starts with this prefix",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the list resource.
+func (r *UsergroupListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *slack.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// List streams the usergroups matching the filters. Disabled usergroups are
+// not listed, as slack_usergroup drops them from the state.
+func (r *UsergroupListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var filters UsergroupListResourceModel
+
+	diags := req.Config.Get(ctx, &filters)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	userGroups, err := r.client.GetUserGroupsContext(ctx, slack.GetUserGroupsOptionIncludeUsers(req.IncludeResource))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list usergroups: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var listed int64
+		for _, ug := range userGroups {
+			if !strings.HasPrefix(ug.Name, filters.NamePrefix.ValueString()) || !strings.HasPrefix(ug.Handle, filters.HandlePrefix.ValueString()) {
+				continue
+			}
+
+			if !push(r.listResult(ctx, req, ug)) {
+				return
+			}
+			listed++
+			if req.Limit > 0 && listed >= req.Limit {
+				return
+			}
+		}
+	}
+}
+
+// listResult returns the identity of the usergroup, and its state when
+// Terraform asks for it.
+func (r *UsergroupListResource) listResult(ctx context.Context, req list.ListRequest, ug slack.UserGroup) list.ListResult {
+	result := req.NewListResult(ctx)
+	result.DisplayName = "@" + ug.Handle
+
+	result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("id"), ug.ID)...)
+	if !req.IncludeResource {
+		return result
+	}
+
+	channelSet, diags := types.SetValueFrom(ctx, types.StringType, ug.Prefs.Channels)
+	result.Diagnostics.Append(diags...)
+	userSet, diags := types.SetValueFrom(ctx, types.StringType, ug.Users)
+	result.Diagnostics.Append(diags...)
+
+	data := UsergroupResourceModel{
+		ID:          types.StringValue(ug.ID),
+		Name:        types.StringValue(ug.Name),
+		Handle:      types.StringValue(ug.Handle),
+		Description: types.StringValue(ug.Description),
+		Channels:    channelSet,
+		Users:       userSet,
+	}
+	result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+
+	return result
+}
@@ -0,0 +1,51 @@
+package slack
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccSlackUsergroupListResourceTest(t *testing.T) {
+	if os.Getenv("TF_ACC") != "1" {
+		t.Skip("Acceptance tests skipped unless env 'TF_ACC' is set to 1")
+		return
+	}
+
+	resourceName := "slack_usergroup.test"
+	userGroup := testAccSlackUserGroup(acctest.RandomWithPrefix(userGroupResourceNamePrefix))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckUserGroupDestroy,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackUserGroupConfig(userGroup),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("id")),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckListed(NewUsergroupListResource(), NewUsergroupResource(), resourceName, map[string]tftypes.Value{
+						"name_prefix":   tftypes.NewValue(tftypes.String, userGroup.Name),
+						"handle_prefix": tftypes.NewValue(tftypes.String, userGroup.Handle),
+					}),
+				),
+			},
+			{
+				ResourceName:    resourceName,
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
@@ -0,0 +1,144 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = normalizedJSONType{}
+var _ basetypes.StringValuableWithSemanticEquals = normalizedJSONValue{}
+
+// normalizedJSONType is a string attribute type holding a JSON document.
+// Documents which only differ by formatting or key order are equal, so
+// reformatting the configuration plans no change.
+type normalizedJSONType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable name of the type.
+func (t normalizedJSONType) String() string {
+	return "normalizedJSONType"
+}
+
+// ValueType returns the value type of the type.
+func (t normalizedJSONType) ValueType(_ context.Context) attr.Value {
+	return normalizedJSONValue{}
+}
+
+// Equal returns whether o is also a normalizedJSONType.
+func (t normalizedJSONType) Equal(o attr.Type) bool {
+	other, ok := o.(normalizedJSONType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString wraps a string value in a normalizedJSONValue.
+func (t normalizedJSONType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return normalizedJSONValue{StringValue: in}, nil
+}
+
+// ValueFromTerraform converts a Terraform value to a normalizedJSONValue.
+func (t normalizedJSONType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	value, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to normalizedJSONValue: %v", diags)
+	}
+
+	return value, nil
+}
+
+// normalizedJSONValue is a value of normalizedJSONType.
+type normalizedJSONValue struct {
+	basetypes.StringValue
+}
+
+// newNormalizedJSONValue returns a known normalizedJSONValue.
+func newNormalizedJSONValue(value string) normalizedJSONValue {
+	return normalizedJSONValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+// Type returns the type of the value.
+func (v normalizedJSONValue) Type(_ context.Context) attr.Type {
+	return normalizedJSONType{}
+}
+
+// Equal returns whether o is the same string. Use StringSemanticEquals to
+// compare the JSON documents.
+func (v normalizedJSONValue) Equal(o attr.Value) bool {
+	other, ok := o.(normalizedJSONValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns whether both values decode to the same JSON
+// document.
+func (v normalizedJSONValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(normalizedJSONValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got: %T", v, newValuable),
+		)
+		return false, diags
+	}
+
+	var oldDocument, newDocument interface{}
+	if err := json.Unmarshal([]byte(v.ValueString()), &oldDocument); err != nil {
+		return false, diags
+	}
+	if err := json.Unmarshal([]byte(newValue.ValueString()), &newDocument); err != nil {
+		return false, diags
+	}
+
+	return reflect.DeepEqual(oldDocument, newDocument), diags
+}
+
+var _ validator.String = jsonValidator{}
+
+// jsonValidator checks that a string is a valid JSON document.
+type jsonValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v jsonValidator) Description(_ context.Context) string {
+	return "value must be valid JSON"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v jsonValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v jsonValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !json.Valid([]byte(req.ConfigValue.ValueString())) {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid JSON", fmt.Sprintf("%s must be valid JSON", req.Path))
+	}
+}
@@ -6,15 +6,16 @@ import (
 	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/slack-go/slack"
 )
 
 // Ensure the implementation satisfies the provider.Provider interface
 var _ provider.Provider = &Provider{}
+var _ provider.ProviderWithListResources = &Provider{}
 
 // Provider defines the provider implementation.
 type Provider struct {
@@ -25,7 +26,8 @@ type Provider struct {
 
 // ProviderModel describes the provider data model.
 type ProviderModel struct {
-	Token types.String `tfsdk:"token"`
+	Token                 types.String `tfsdk:"token"`
+	AppConfigurationToken types.String `tfsdk:"app_configuration_token"`
 }
 
 // NewFrameworkProvider creates a new Slack provider factory function.
@@ -52,6 +54,11 @@ func (p *Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp *pro
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"app_configuration_token": schema.StringAttribute{
+				MarkdownDescription: "The app configuration token used to manage apps with `slack_app_manifest`",
+				Optional:            true,
+				Sensitive:           true,
+			},
 		},
 	}
 }
@@ -92,18 +99,46 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 	}
 
 	// Create Slack client
-	slackClient := slack.New(token)
+	slackClient := NewClient(token)
+
+	// The app configuration token is only needed by slack_app_manifest
+	slackClient.appConfigurationToken = os.Getenv("SLACK_APP_CONFIGURATION_TOKEN")
+	if !data.AppConfigurationToken.IsNull() {
+		slackClient.appConfigurationToken = data.AppConfigurationToken.ValueString()
+	}
 
-	// Make the Slack client available during DataSource and Resource type Configure methods
+	// Make the Slack client available during DataSource, Resource and ListResource type Configure methods
 	resp.DataSourceData = slackClient
 	resp.ResourceData = slackClient
+	resp.ListResourceData = slackClient
 }
 
 // Resources returns the list of resources supported by this provider.
 func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewAppApprovalResource,
+		NewAppManifestResource,
+		NewAppRestrictionResource,
+		NewCanvasResource,
 		NewConversationResource,
+		NewConversationBookmarkResource,
+		NewConversationPinnedMessageResource,
+		NewConversationPrefsResource,
+		NewConversationSharedInviteResource,
+		NewEmojiResource,
+		NewInformationBarrierResource,
+		NewMessageResource,
+		NewReminderResource,
+		NewScheduledMessageResource,
+		NewTeamDefaultChannelsResource,
+		NewTeamProfileFieldResource,
+		NewTeamSettingsResource,
+		NewUserInviteResource,
+		NewUserProfileResource,
+		NewUserRoleResource,
+		NewUserStatusResource,
 		NewUsergroupResource,
+		NewUsergroupChannelLinkResource,
 	}
 }
 
@@ -111,8 +146,21 @@ func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewConversationDataSource,
+		NewConversationMembersDataSource,
+		NewEmojisDataSource,
+		NewTeamDataSource,
+		NewTeamProfileDataSource,
 		NewUserDataSource,
 		NewUsergroupDataSource,
+		NewUsergroupsDataSource,
+	}
+}
+
+// ListResources returns the list resources supported by this provider.
+func (p *Provider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewConversationListResource,
+		NewUsergroupListResource,
 	}
 }
 
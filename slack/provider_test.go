@@ -79,6 +79,15 @@ func testAccPreCheck(t *testing.T) {
 	}
 }
 
+// testAccPreCheckAdmin skips the tests of the admin.* Web API methods, which
+// need an Enterprise Grid org token. Set SLACK_TEST_ADMIN=1 when SLACK_TOKEN is
+// such a token.
+func testAccPreCheckAdmin(t *testing.T) {
+	if os.Getenv("SLACK_TEST_ADMIN") != "1" {
+		t.Skip("Admin acceptance tests skipped unless env 'SLACK_TEST_ADMIN' is set to 1")
+	}
+}
+
 // testAccProvider is a global test provider instance for backward compatibility
 // Note: In Plugin Framework, providers don't have a Meta() method like SDK v2
 // Use getTestSlackClient() instead for testing
@@ -0,0 +1,301 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/slack-go/slack"
+)
+
+const (
+	errAppNotFound = "app_not_found"
+)
+
+var _ resource.Resource = &AppResolutionResource{}
+var _ resource.ResourceWithImportState = &AppResolutionResource{}
+
+// NewAppApprovalResource creates a new Slack app approval resource.
+func NewAppApprovalResource() resource.Resource {
+	return &AppResolutionResource{
+		typeName:      "_app_approval",
+		action:        "approve",
+		resolveMethod: "admin.apps.approve",
+		listMethod:    "admin.apps.approved.list",
+	}
+}
+
+// AppResolutionResource implements the Slack app approval and restriction
+// resources, which only differ by the admin.apps methods they call.
+type AppResolutionResource struct {
+	client *Client
+
+	typeName      string
+	action        string
+	resolveMethod string
+	listMethod    string
+}
+
+// AppResolutionResourceModel describes the app approval and restriction resources data model.
+type AppResolutionResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	AppID        types.String `tfsdk:"app_id"`
+	TeamID       types.String `tfsdk:"team_id"`
+	EnterpriseID types.String `tfsdk:"enterprise_id"`
+	AppName      types.String `tfsdk:"app_name"`
+}
+
+// resolvedApp is an app returned by admin.apps.approved.list and
+// admin.apps.restricted.list.
+type resolvedApp struct {
+	App struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"app"`
+}
+
+// listResolvedAppsResponse is the admin.apps.approved.list and
+// admin.apps.restricted.list answer.
+type listResolvedAppsResponse struct {
+	ApprovedApps     []resolvedApp          `json:"approved_apps"`
+	RestrictedApps   []resolvedApp          `json:"restricted_apps"`
+	ResponseMetadata slack.ResponseMetadata `json:"response_metadata"`
+	slack.SlackResponse
+}
+
+// Metadata returns the resource type name.
+func (r *AppResolutionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.typeName
+}
+
+// Schema defines the schema for the resource.
+func (r *AppResolutionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Manages the %s resolution of a Slack app in a workspace or organization", r.action),
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The resource ID, in the form `<team_id or enterprise_id>/<app_id>`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_id": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The ID of the app to %s", r.action),
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workspace",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("enterprise_id")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enterprise_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Enterprise Grid organization",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"app_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the app",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *AppResolutionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *slack.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create approves or restricts the app.
+func (r *AppResolutionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AppResolutionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	values := appResolutionValues(data)
+	if err := r.client.callMethod(ctx, r.resolveMethod, values, &slack.SlackResponse{}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to %s app %s: %s", r.action, data.AppID.ValueString(), err))
+		return
+	}
+
+	app, err := r.findApp(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read app %s: %s", data.AppID.ValueString(), err))
+		return
+	}
+
+	data.ID = types.StringValue(appResolutionScope(data) + "/" + data.AppID.ValueString())
+	data.AppName = types.StringNull()
+	if app != nil {
+		data.AppName = types.StringValue(app.App.Name)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read checks that the app is still approved or restricted.
+func (r *AppResolutionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AppResolutionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	app, err := r.findApp(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read app %s: %s", data.AppID.ValueString(), err))
+		return
+	}
+
+	// The resolution was cleared or changed from the admin settings
+	if app == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.AppName = types.StringValue(app.App.Name)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never called as every argument requires a replacement.
+func (r *AppResolutionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AppResolutionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete clears the resolution of the app, which goes back to needing an
+// approval.
+func (r *AppResolutionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AppResolutionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	values := appResolutionValues(data)
+	err := r.client.callMethod(ctx, "admin.apps.clearResolution", values, &slack.SlackResponse{})
+	if err != nil && err.Error() != errInvalidAppID && err.Error() != errAppNotFound {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to clear resolution of app %s: %s", data.AppID.ValueString(), err))
+		return
+	}
+}
+
+// ImportState imports an app resolution using `<team_id or enterprise_id>/<app_id>`.
+func (r *AppResolutionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <team_id or enterprise_id>/<app_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	// Enterprise Grid organization IDs start with an E, workspace IDs with a T
+	scope := path.Root("team_id")
+	if strings.HasPrefix(parts[0], "E") {
+		scope = path.Root("enterprise_id")
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, scope, parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("app_id"), parts[1])...)
+}
+
+// findApp returns the app from the approved or restricted list, or nil when
+// it is not listed.
+func (r *AppResolutionResource) findApp(ctx context.Context, data AppResolutionResourceModel) (*resolvedApp, error) {
+	cursor := ""
+	for {
+		values := appResolutionValues(data)
+		values.Del("app_id")
+		values.Set("limit", "1000")
+		if cursor != "" {
+			values.Set("cursor", cursor)
+		}
+
+		var page listResolvedAppsResponse
+		if err := r.client.callMethod(ctx, r.listMethod, values, &page); err != nil {
+			return nil, err
+		}
+
+		for _, apps := range [][]resolvedApp{page.ApprovedApps, page.RestrictedApps} {
+			for i, app := range apps {
+				if app.App.ID == data.AppID.ValueString() {
+					return &apps[i], nil
+				}
+			}
+		}
+
+		cursor = page.ResponseMetadata.Cursor
+		if cursor == "" {
+			return nil, nil
+		}
+	}
+}
+
+// appResolutionValues returns the app and the workspace or organization
+// parameters of the admin.apps methods.
+func appResolutionValues(data AppResolutionResourceModel) url.Values {
+	values := url.Values{"app_id": {data.AppID.ValueString()}}
+	if !data.TeamID.IsNull() {
+		values.Set("team_id", data.TeamID.ValueString())
+	} else {
+		values.Set("enterprise_id", data.EnterpriseID.ValueString())
+	}
+	return values
+}
+
+// appResolutionScope returns the ID of the workspace or organization.
+func appResolutionScope(data AppResolutionResourceModel) string {
+	if !data.TeamID.IsNull() {
+		return data.TeamID.ValueString()
+	}
+	return data.EnterpriseID.ValueString()
+}
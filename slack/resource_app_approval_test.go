@@ -0,0 +1,54 @@
+package slack
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSlackAppApprovalTest(t *testing.T) {
+	if os.Getenv("TF_ACC") != "1" {
+		t.Skip("Acceptance tests skipped unless env 'TF_ACC' is set to 1")
+		return
+	}
+	testAccPreCheckAdmin(t)
+	appID := os.Getenv("SLACK_TEST_APP_ID")
+	if appID == "" {
+		t.Skip("App approval acceptance tests skipped unless env 'SLACK_TEST_APP_ID' is set")
+	}
+
+	resourceName := "slack_app_approval.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackAppApprovalConfig(appID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "app_id", appID),
+					resource.TestCheckResourceAttrPair(resourceName, "team_id", "data.slack_team.current", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "app_name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSlackAppApprovalConfig(appID string) string {
+	return fmt.Sprintf(`
+data slack_team current {}
+
+resource slack_app_approval test {
+  app_id  = "%s"
+  team_id = data.slack_team.current.id
+}
+`, appID)
+}
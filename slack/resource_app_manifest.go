@@ -0,0 +1,403 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/slack-go/slack"
+)
+
+const (
+	errInvalidAppID = "invalid_app_id"
+)
+
+var _ resource.Resource = &AppManifestResource{}
+var _ resource.ResourceWithImportState = &AppManifestResource{}
+var _ resource.ResourceWithModifyPlan = &AppManifestResource{}
+
+// NewAppManifestResource creates a new Slack app manifest resource.
+func NewAppManifestResource() resource.Resource {
+	return &AppManifestResource{}
+}
+
+// AppManifestResource implements the Slack app manifest resource.
+type AppManifestResource struct {
+	client *Client
+}
+
+// AppManifestResourceModel describes the app manifest resource data model.
+type AppManifestResourceModel struct {
+	ID                types.String        `tfsdk:"id"`
+	Manifest          normalizedJSONValue `tfsdk:"manifest"`
+	AppID             types.String        `tfsdk:"app_id"`
+	ClientID          types.String        `tfsdk:"client_id"`
+	ClientSecret      types.String        `tfsdk:"client_secret"`
+	VerificationToken types.String        `tfsdk:"verification_token"`
+	SigningSecret     types.String        `tfsdk:"signing_secret"`
+	OAuthAuthorizeURL types.String        `tfsdk:"oauth_authorize_url"`
+}
+
+// manifestError is an error reported on a manifest by apps.manifest.*.
+type manifestError struct {
+	Message string `json:"message"`
+	Pointer string `json:"pointer"`
+}
+
+// manifestResponse is the answer of apps.manifest.create, update and
+// validate.
+type manifestResponse struct {
+	AppID       string `json:"app_id"`
+	Credentials struct {
+		ClientID          string `json:"client_id"`
+		ClientSecret      string `json:"client_secret"`
+		VerificationToken string `json:"verification_token"`
+		SigningSecret     string `json:"signing_secret"`
+	} `json:"credentials"`
+	OAuthAuthorizeURL string          `json:"oauth_authorize_url"`
+	Errors            []manifestError `json:"errors"`
+	slack.SlackResponse
+}
+
+// exportManifestResponse is the apps.manifest.export answer.
+type exportManifestResponse struct {
+	Manifest json.RawMessage `json:"manifest"`
+	slack.SlackResponse
+}
+
+// Metadata returns the resource type name.
+func (r *AppManifestResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_manifest"
+}
+
+// Schema defines the schema for the resource.
+func (r *AppManifestResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Slack app from its manifest. Requires the provider `app_configuration_token`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The app ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"manifest": schema.StringAttribute{
+				MarkdownDescription: "The JSON encoded app manifest. Only a JSON string is accepted: wrap an HCL object in `jsonencode()`, or read a JSON file with `file()`. Formatting and key order changes are ignored.",
+				Required:            true,
+				CustomType:          normalizedJSONType{},
+				Validators: []validator.String{
+					jsonValidator{},
+				},
+			},
+			"app_id": schema.StringAttribute{
+				MarkdownDescription: "The app ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "The OAuth client ID of the app",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"client_secret": schema.StringAttribute{
+				MarkdownDescription: "The OAuth client secret of the app",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"verification_token": schema.StringAttribute{
+				MarkdownDescription: "The deprecated verification token of the app",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"signing_secret": schema.StringAttribute{
+				MarkdownDescription: "The secret used to verify the requests sent by Slack to the app",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"oauth_authorize_url": schema.StringAttribute{
+				MarkdownDescription: "The URL to install the app in a workspace",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *AppManifestResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *slack.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan validates the planned manifest with apps.manifest.validate when
+// it changes. Without an app configuration token, the missing token is
+// reported when applying instead.
+func (r *AppManifestResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil || r.client.appConfigurationToken == "" {
+		return
+	}
+
+	var data AppManifestResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Manifest.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state AppManifestResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		equal, diags := state.Manifest.StringSemanticEquals(ctx, data.Manifest)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() || equal {
+			return
+		}
+	}
+
+	values := url.Values{"manifest": {data.Manifest.ValueString()}}
+	if !data.AppID.IsUnknown() && !data.AppID.IsNull() {
+		values.Set("app_id", data.AppID.ValueString())
+	}
+
+	var validated manifestResponse
+	if err := r.client.callMethodWithToken(ctx, r.client.appConfigurationToken, "apps.manifest.validate", values, &validated); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("manifest"), "Invalid Manifest", manifestErrorDetail(err, validated.Errors))
+	}
+}
+
+// Create creates the app.
+func (r *AppManifestResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AppManifestResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || r.checkToken(&resp.Diagnostics) {
+		return
+	}
+
+	var created manifestResponse
+	values := url.Values{"manifest": {data.Manifest.ValueString()}}
+	if err := r.client.callMethodWithToken(ctx, r.client.appConfigurationToken, "apps.manifest.create", values, &created); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create app: %s", manifestErrorDetail(err, created.Errors)))
+		return
+	}
+
+	data.ID = types.StringValue(created.AppID)
+	data.AppID = types.StringValue(created.AppID)
+	data.ClientID = types.StringValue(created.Credentials.ClientID)
+	data.ClientSecret = types.StringValue(created.Credentials.ClientSecret)
+	data.VerificationToken = types.StringValue(created.Credentials.VerificationToken)
+	data.SigningSecret = types.StringValue(created.Credentials.SigningSecret)
+	data.OAuthAuthorizeURL = types.StringValue(created.OAuthAuthorizeURL)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read exports the manifest of the app to detect drift.
+func (r *AppManifestResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AppManifestResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || r.checkToken(&resp.Diagnostics) {
+		return
+	}
+
+	var exported exportManifestResponse
+	values := url.Values{"app_id": {data.ID.ValueString()}}
+	if err := r.client.callMethodWithToken(ctx, r.client.appConfigurationToken, "apps.manifest.export", values, &exported); err != nil {
+		if err.Error() == errInvalidAppID {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to export manifest of app %s: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	// Slack fills in defaults for settings missing from the manifest, so the
+	// configured manifest is kept as long as the exported one matches it
+	matches, err := manifestContains(exported.Manifest, data.Manifest.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to compare manifest of app %s: %s", data.ID.ValueString(), err))
+		return
+	}
+	if !matches {
+		data.Manifest = newNormalizedJSONValue(string(exported.Manifest))
+	}
+
+	data.AppID = data.ID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the manifest of the app.
+func (r *AppManifestResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state AppManifestResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || r.checkToken(&resp.Diagnostics) {
+		return
+	}
+
+	var updated manifestResponse
+	values := url.Values{
+		"app_id":   {state.ID.ValueString()},
+		"manifest": {data.Manifest.ValueString()},
+	}
+	if err := r.client.callMethodWithToken(ctx, r.client.appConfigurationToken, "apps.manifest.update", values, &updated); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update app %s: %s", state.ID.ValueString(), manifestErrorDetail(err, updated.Errors)))
+		return
+	}
+
+	// The credentials are only returned on creation, imported apps have none
+	data.ID = state.ID
+	data.AppID = state.AppID
+	data.ClientID = state.ClientID
+	data.ClientSecret = state.ClientSecret
+	data.VerificationToken = state.VerificationToken
+	data.SigningSecret = state.SigningSecret
+	data.OAuthAuthorizeURL = state.OAuthAuthorizeURL
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the app.
+func (r *AppManifestResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AppManifestResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || r.checkToken(&resp.Diagnostics) {
+		return
+	}
+
+	values := url.Values{"app_id": {data.ID.ValueString()}}
+	err := r.client.callMethodWithToken(ctx, r.client.appConfigurationToken, "apps.manifest.delete", values, &slack.SlackResponse{})
+	if err != nil && err.Error() != errInvalidAppID {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete app %s: %s", data.ID.ValueString(), err))
+		return
+	}
+}
+
+// ImportState imports a Slack app using its ID. The credentials are only
+// returned when the app is created, so they are not imported.
+func (r *AppManifestResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// checkToken reports an error and returns true when the provider has no app
+// configuration token.
+func (r *AppManifestResource) checkToken(diags *diag.Diagnostics) bool {
+	if r.client.appConfigurationToken != "" {
+		return false
+	}
+
+	diags.AddError(
+		"Missing App Configuration Token",
+		"slack_app_manifest needs an app configuration token. "+
+			"Please set the SLACK_APP_CONFIGURATION_TOKEN environment variable or configure app_configuration_token in the provider configuration.",
+	)
+	return true
+}
+
+// manifestErrorDetail appends the errors reported on the manifest to err.
+func manifestErrorDetail(err error, manifestErrors []manifestError) string {
+	details := []string{err.Error()}
+	for _, e := range manifestErrors {
+		details = append(details, fmt.Sprintf("%s: %s", e.Pointer, e.Message))
+	}
+	return strings.Join(details, "\n")
+}
+
+// manifestContains returns whether every value set in the configured manifest
+// has the same value in the exported one.
+func manifestContains(exported json.RawMessage, configured string) (bool, error) {
+	var exportedValue, configuredValue interface{}
+	if err := json.Unmarshal(exported, &exportedValue); err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal([]byte(configured), &configuredValue); err != nil {
+		// An imported app has no configured manifest yet
+		return false, nil
+	}
+
+	return jsonContains(exportedValue, configuredValue), nil
+}
+
+// jsonContains returns whether every object key of want is found in got with
+// the same value. Arrays must have the same elements, in any order.
+func jsonContains(got, want interface{}) bool {
+	switch want := want.(type) {
+	case map[string]interface{}:
+		got, ok := got.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for key, value := range want {
+			if !jsonContains(got[key], value) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		got, ok := got.([]interface{})
+		if !ok || len(got) != len(want) {
+			return false
+		}
+		for _, value := range want {
+			found := false
+			for _, element := range got {
+				if jsonContains(element, value) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(got, want)
+	}
+}
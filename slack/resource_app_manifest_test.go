@@ -0,0 +1,89 @@
+package slack
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSlackAppManifestTest(t *testing.T) {
+	if os.Getenv("TF_ACC") != "1" {
+		t.Skip("Acceptance tests skipped unless env 'TF_ACC' is set to 1")
+		return
+	}
+	if os.Getenv("SLACK_APP_CONFIGURATION_TOKEN") == "" {
+		t.Skip("App manifest acceptance tests skipped unless env 'SLACK_APP_CONFIGURATION_TOKEN' is set")
+	}
+
+	resourceName := "slack_app_manifest.test"
+	name := acctest.RandomWithPrefix("test-acc-slack-app")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackAppManifestConfig(name, "Created by the Terraform acceptance tests"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "app_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "id", resourceName, "app_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "client_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "client_secret"),
+					resource.TestCheckResourceAttrSet(resourceName, "signing_secret"),
+					resource.TestCheckResourceAttrSet(resourceName, "oauth_authorize_url"),
+				),
+			},
+			{
+				Config: testAccSlackAppManifestConfig(name, "Updated by the Terraform acceptance tests"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "client_secret"),
+				),
+			},
+			{
+				// The same manifest, reformatted as a JSON string
+				Config:   testAccSlackAppManifestConfigJSON(name, "Updated by the Terraform acceptance tests"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccSlackAppManifestConfig(name, description string) string {
+	return fmt.Sprintf(`
+resource slack_app_manifest test {
+  manifest = jsonencode({
+    display_information = {
+      name        = "%s"
+      description = "%s"
+    }
+    features = {
+      bot_user = {
+        display_name = "%s"
+      }
+    }
+    oauth_config = {
+      scopes = {
+        bot = ["chat:write"]
+      }
+    }
+  })
+}
+`, name, description, name)
+}
+
+func testAccSlackAppManifestConfigJSON(name, description string) string {
+	return fmt.Sprintf(`
+resource slack_app_manifest test {
+  manifest = <<-EOT
+    {
+      "oauth_config": {"scopes": {"bot": ["chat:write"]}},
+      "features": {"bot_user": {"display_name": "%s"}},
+      "display_information": {"description": "%s", "name": "%s"}
+    }
+  EOT
+}
+`, name, description, name)
+}
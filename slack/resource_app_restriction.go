@@ -0,0 +1,15 @@
+package slack
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// NewAppRestrictionResource creates a new Slack app restriction resource.
+func NewAppRestrictionResource() resource.Resource {
+	return &AppResolutionResource{
+		typeName:      "_app_restriction",
+		action:        "restrict",
+		resolveMethod: "admin.apps.restrict",
+		listMethod:    "admin.apps.restricted.list",
+	}
+}
@@ -0,0 +1,392 @@
+package slack
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/slack-go/slack"
+)
+
+const (
+	errFileNotFound   = "file_not_found"
+	errFileDeleted    = "file_deleted"
+	errCanvasNotFound = "canvas_not_found"
+)
+
+var _ resource.Resource = &CanvasResource{}
+var _ resource.ResourceWithImportState = &CanvasResource{}
+
+// NewCanvasResource creates a new Slack canvas resource.
+func NewCanvasResource() resource.Resource {
+	return &CanvasResource{}
+}
+
+// CanvasResource implements the Slack canvas resource.
+type CanvasResource struct {
+	client *Client
+}
+
+// CanvasResourceModel describes the canvas resource data model.
+type CanvasResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Title         types.String `tfsdk:"title"`
+	Markdown      types.String `tfsdk:"markdown"`
+	ChannelID     types.String `tfsdk:"channel_id"`
+	UserAccess    types.Map    `tfsdk:"user_access"`
+	ChannelAccess types.Map    `tfsdk:"channel_access"`
+	ContentHash   types.String `tfsdk:"content_hash"`
+}
+
+// canvasRename is the canvases.edit change renaming a canvas, which slack-go
+// does not support.
+type canvasRename struct {
+	Operation    string                `json:"operation"`
+	TitleContent slack.DocumentContent `json:"title_content"`
+}
+
+// Metadata returns the resource type name.
+func (r *CanvasResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_canvas"
+}
+
+// Schema defines the schema for the resource.
+func (r *CanvasResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	accessValidators := []validator.Map{
+		mapvalidator.ValueStringsAre(stringvalidator.OneOf("read", "write")),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Slack canvas, standalone or attached to a channel",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The canvas ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "The title of the canvas. Channel canvases are titled after the channel unless set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"markdown": schema.StringAttribute{
+				MarkdownDescription: "The content of the canvas, in markdown",
+				Required:            true,
+			},
+			"channel_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the channel the canvas is attached to. A channel has at most one canvas.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_access": schema.MapAttribute{
+				MarkdownDescription: "Access granted to users, `read` or `write`, by user ID",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators:          accessValidators,
+			},
+			"channel_access": schema.MapAttribute{
+				MarkdownDescription: "Access granted to the members of channels, `read` or `write`, by channel ID",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators:          accessValidators,
+			},
+			"content_hash": schema.StringAttribute{
+				MarkdownDescription: "The SHA-256 of the canvas document as stored by Slack, used to detect edits made in Slack",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *CanvasResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *slack.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the canvas, then grants the configured access.
+func (r *CanvasResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CanvasResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content := slack.DocumentContent{Type: "markdown", Markdown: data.Markdown.ValueString()}
+
+	var canvasID string
+	var err error
+	if !data.ChannelID.IsNull() {
+		canvasID, err = r.client.CreateChannelCanvasContext(ctx, data.ChannelID.ValueString(), content)
+	} else {
+		canvasID, err = r.client.CreateCanvasContext(ctx, data.Title.ValueString(), content)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create canvas: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(canvasID)
+
+	// conversations.canvases.create has no title parameter
+	if !data.ChannelID.IsNull() && !data.Title.IsUnknown() && !data.Title.IsNull() {
+		if err := r.rename(ctx, canvasID, data.Title.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to rename canvas %s: %s", canvasID, err))
+			return
+		}
+	}
+
+	previous := CanvasResourceModel{
+		UserAccess:    types.MapNull(types.StringType),
+		ChannelAccess: types.MapNull(types.StringType),
+	}
+	if err := r.updateAccess(ctx, canvasID, data, previous); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set access to canvas %s: %s", canvasID, err))
+		return
+	}
+
+	if err := r.refresh(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read canvas %s: %s", canvasID, err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read detects edits made in Slack by comparing the hash of the canvas
+// document with the one recorded at the last apply.
+func (r *CanvasResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CanvasResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	appliedHash := data.ContentHash
+	if err := r.refresh(ctx, &data); err != nil {
+		if err.Error() == errFileNotFound || err.Error() == errFileDeleted {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read canvas %s: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	// The markdown cannot be read back, so it is cleared to plan the
+	// configured content again
+	if !appliedHash.IsNull() && !data.ContentHash.Equal(appliedHash) {
+		data.Markdown = types.StringNull()
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update replaces the content of the canvas and updates its title and access.
+func (r *CanvasResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state CanvasResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	canvasID := state.ID.ValueString()
+
+	if !data.Markdown.Equal(state.Markdown) {
+		err := r.client.EditCanvasContext(ctx, slack.EditCanvasParams{
+			CanvasID: canvasID,
+			Changes: []slack.CanvasChange{{
+				Operation:       "replace",
+				DocumentContent: slack.DocumentContent{Type: "markdown", Markdown: data.Markdown.ValueString()},
+			}},
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to edit canvas %s: %s", canvasID, err))
+			return
+		}
+	}
+
+	if !data.Title.IsUnknown() && !data.Title.IsNull() && !data.Title.Equal(state.Title) {
+		if err := r.rename(ctx, canvasID, data.Title.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to rename canvas %s: %s", canvasID, err))
+			return
+		}
+	}
+
+	if err := r.updateAccess(ctx, canvasID, data, state); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set access to canvas %s: %s", canvasID, err))
+		return
+	}
+
+	data.ID = state.ID
+	if err := r.refresh(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read canvas %s: %s", canvasID, err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the canvas.
+func (r *CanvasResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CanvasResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteCanvasContext(ctx, data.ID.ValueString())
+	if err != nil && err.Error() != errCanvasNotFound {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete canvas %s: %s", data.ID.ValueString(), err))
+		return
+	}
+}
+
+// ImportState imports a Slack canvas using its ID.
+func (r *CanvasResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// refresh sets the title and content hash of the canvas from Slack.
+func (r *CanvasResource) refresh(ctx context.Context, data *CanvasResourceModel) error {
+	file, _, _, err := r.client.GetFileInfoContext(ctx, data.ID.ValueString(), 0, 0)
+	if err != nil {
+		return err
+	}
+
+	hash := sha256.New()
+	if err := r.client.GetFileContext(ctx, file.URLPrivateDownload, hash); err != nil {
+		return fmt.Errorf("unable to download canvas: %s", err)
+	}
+
+	data.Title = types.StringValue(file.Title)
+	data.ContentHash = types.StringValue(hex.EncodeToString(hash.Sum(nil)))
+	return nil
+}
+
+// rename sets the title of the canvas.
+func (r *CanvasResource) rename(ctx context.Context, canvasID, title string) error {
+	changes, err := json.Marshal([]canvasRename{{
+		Operation:    "rename",
+		TitleContent: slack.DocumentContent{Type: "markdown", Markdown: title},
+	}})
+	if err != nil {
+		return err
+	}
+
+	values := url.Values{
+		"canvas_id": {canvasID},
+		"changes":   {string(changes)},
+	}
+	return r.client.callMethod(ctx, "canvases.edit", values, &slack.SlackResponse{})
+}
+
+// updateAccess grants the access levels of data which differ from previous,
+// and revokes the access of the users and channels removed from data.
+func (r *CanvasResource) updateAccess(ctx context.Context, canvasID string, data, previous CanvasResourceModel) error {
+	userAccess, previousUserAccess := map[string]string{}, map[string]string{}
+	channelAccess, previousChannelAccess := map[string]string{}, map[string]string{}
+	for _, m := range []struct {
+		value  types.Map
+		target *map[string]string
+	}{
+		{data.UserAccess, &userAccess},
+		{previous.UserAccess, &previousUserAccess},
+		{data.ChannelAccess, &channelAccess},
+		{previous.ChannelAccess, &previousChannelAccess},
+	} {
+		if m.value.IsNull() || m.value.IsUnknown() {
+			continue
+		}
+		if diags := m.value.ElementsAs(ctx, m.target, false); diags.HasError() {
+			return fmt.Errorf("unable to read access")
+		}
+	}
+
+	for _, level := range []string{"read", "write"} {
+		params := slack.SetCanvasAccessParams{
+			CanvasID:    canvasID,
+			AccessLevel: level,
+			UserIDs:     changedAccess(userAccess, previousUserAccess, level),
+			ChannelIDs:  changedAccess(channelAccess, previousChannelAccess, level),
+		}
+		if len(params.UserIDs) == 0 && len(params.ChannelIDs) == 0 {
+			continue
+		}
+		if err := r.client.SetCanvasAccessContext(ctx, params); err != nil {
+			return err
+		}
+	}
+
+	params := slack.DeleteCanvasAccessParams{
+		CanvasID:   canvasID,
+		UserIDs:    removedAccess(userAccess, previousUserAccess),
+		ChannelIDs: removedAccess(channelAccess, previousChannelAccess),
+	}
+	if len(params.UserIDs) == 0 && len(params.ChannelIDs) == 0 {
+		return nil
+	}
+	return r.client.DeleteCanvasAccessContext(ctx, params)
+}
+
+// changedAccess returns the sorted IDs given level in access which did not
+// have it in previous.
+func changedAccess(access, previous map[string]string, level string) []string {
+	var ids []string
+	for id, l := range access {
+		if l == level && previous[id] != level {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// removedAccess returns the sorted IDs of previous missing from access.
+func removedAccess(access, previous map[string]string) []string {
+	var ids []string
+	for id := range previous {
+		if _, ok := access[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
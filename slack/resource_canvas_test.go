@@ -0,0 +1,171 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/slack-go/slack"
+)
+
+func TestAccSlackCanvasTest(t *testing.T) {
+	if os.Getenv("TF_ACC") != "1" {
+		t.Skip("Acceptance tests skipped unless env 'TF_ACC' is set to 1")
+		return
+	}
+
+	resourceName := "slack_canvas.test"
+	title := acctest.RandomWithPrefix("test-acc-slack-canvas")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackCanvasConfig(title, "# Ownership\n\nOwned by the platform team.", "read"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "title", title),
+					resource.TestCheckResourceAttrSet(resourceName, "content_hash"),
+					resource.TestCheckResourceAttr(resourceName, fmt.Sprintf("user_access.%s", testUser00.id), "read"),
+				),
+			},
+			{
+				Config: testAccSlackCanvasConfig(title+"-renamed", "# Ownership\n\nOwned by the data team.", "write"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "title", title+"-renamed"),
+					resource.TestCheckResourceAttr(resourceName, fmt.Sprintf("user_access.%s", testUser00.id), "write"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSlackCanvasChannelTest(t *testing.T) {
+	if os.Getenv("TF_ACC") != "1" {
+		t.Skip("Acceptance tests skipped unless env 'TF_ACC' is set to 1")
+		return
+	}
+
+	resourceName := "slack_canvas.test"
+	channel := createTestConversation(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource slack_canvas test {
+  channel_id = "%s"
+  markdown   = "Escalate to the on-call engineer."
+}
+`, channel.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "channel_id", channel.ID),
+					resource.TestCheckResourceAttrSet(resourceName, "title"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSlackCanvasConfig(title, markdown, access string) string {
+	return fmt.Sprintf(`
+resource slack_canvas test {
+  title    = "%s"
+  markdown = %q
+
+  user_access = {
+    "%s" = "%s"
+  }
+}
+`, title, markdown, testUser00.id, access)
+}
+
+func TestCanvasUpdateAccess(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		calls = append(calls, fmt.Sprintf("%s level=%s users=%s channels=%s",
+			strings.TrimPrefix(r.URL.Path, "/"), r.Form.Get("access_level"), r.Form.Get("user_ids"), r.Form.Get("channel_ids")))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"ok":true}`)
+	}))
+	defer server.Close()
+
+	r := &CanvasResource{client: &Client{Client: slack.New("xoxb-test", slack.OptionAPIURL(server.URL+"/"))}}
+	ctx := context.Background()
+
+	accessMap := func(access map[string]string) types.Map {
+		value, diags := types.MapValueFrom(ctx, types.StringType, access)
+		if diags.HasError() {
+			t.Fatal(diags)
+		}
+		return value
+	}
+
+	created := CanvasResourceModel{
+		UserAccess:    accessMap(map[string]string{"U1": "read", "U2": "write"}),
+		ChannelAccess: types.MapNull(types.StringType),
+	}
+	// A created canvas has no previous access
+	if err := r.updateAccess(ctx, "F1", created, CanvasResourceModel{}); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		`canvases.access.set level=read users=["U1"] channels=`,
+		`canvases.access.set level=write users=["U2"] channels=`,
+	}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("create: got calls %q, want %q", calls, want)
+	}
+
+	calls = nil
+	updated := CanvasResourceModel{
+		UserAccess:    accessMap(map[string]string{"U1": "write"}),
+		ChannelAccess: accessMap(map[string]string{"C1": "read"}),
+	}
+	if err := r.updateAccess(ctx, "F1", updated, created); err != nil {
+		t.Fatal(err)
+	}
+	want = []string{
+		`canvases.access.set level=read users= channels=["C1"]`,
+		`canvases.access.set level=write users=["U1"] channels=`,
+		`canvases.access.delete level= users=["U2"] channels=`,
+	}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("update: got calls %q, want %q", calls, want)
+	}
+}
+
+func TestCanvasChangedAndRemovedAccess(t *testing.T) {
+	access := map[string]string{"U1": "read", "U2": "write", "U3": "write"}
+	previous := map[string]string{"U2": "read", "U3": "write", "U4": "read"}
+
+	if got, want := changedAccess(access, previous, "read"), []string{"U1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("changedAccess read: got %v, want %v", got, want)
+	}
+	if got, want := changedAccess(access, previous, "write"), []string{"U2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("changedAccess write: got %v, want %v", got, want)
+	}
+	if got, want := removedAccess(access, previous), []string{"U4"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("removedAccess: got %v, want %v", got, want)
+	}
+	if got := changedAccess(access, nil, "write"); !reflect.DeepEqual(got, []string{"U2", "U3"}) {
+		t.Errorf("changedAccess without previous access: got %v", got)
+	}
+	if got := removedAccess(access, nil); got != nil {
+		t.Errorf("removedAccess without previous access: got %v", got)
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -25,6 +26,7 @@ const (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &ConversationResource{}
 var _ resource.ResourceWithImportState = &ConversationResource{}
+var _ resource.ResourceWithIdentity = &ConversationResource{}
 
 // NewConversationResource creates a new Slack conversation resource.
 func NewConversationResource() resource.Resource {
@@ -33,7 +35,7 @@ func NewConversationResource() resource.Resource {
 
 // ConversationResource defines the resource implementation
 type ConversationResource struct {
-	client *slack.Client
+	client *Client
 }
 
 // ConversationResourceModel describes the resource data model
@@ -167,13 +169,26 @@ func (r *ConversationResource) Schema(_ context.Context, _ resource.SchemaReques
 	}
 }
 
+// IdentitySchema defines the identity of the resource, used to import it and
+// by the list resource.
+func (r *ConversationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The conversation ID",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *ConversationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*slack.Client)
+	client, ok := req.ProviderData.(*Client)
 
 	if !ok {
 		resp.Diagnostics.AddError(
@@ -270,6 +285,7 @@ func (r *ConversationResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("id"), data.ID)...)
 }
 
 func (r *ConversationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -341,6 +357,7 @@ func (r *ConversationResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("id"), data.ID)...)
 }
 
 // Update updates an existing Slack conversation resource.
@@ -547,7 +564,7 @@ func (r *ConversationResource) Delete(ctx context.Context, req resource.DeleteRe
 
 // ImportState imports an existing Slack conversation resource.
 func (r *ConversationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 // contains checks if a string is in a slice
@@ -0,0 +1,275 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/slack-go/slack"
+)
+
+const (
+	errNotFound = "not_found"
+)
+
+var _ resource.Resource = &ConversationBookmarkResource{}
+var _ resource.ResourceWithImportState = &ConversationBookmarkResource{}
+
+// NewConversationBookmarkResource creates a new Slack conversation bookmark resource.
+func NewConversationBookmarkResource() resource.Resource {
+	return &ConversationBookmarkResource{}
+}
+
+// ConversationBookmarkResource implements the Slack conversation bookmark resource.
+type ConversationBookmarkResource struct {
+	client *Client
+}
+
+// ConversationBookmarkResourceModel describes the conversation bookmark resource data model.
+type ConversationBookmarkResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	ChannelID  types.String `tfsdk:"channel_id"`
+	BookmarkID types.String `tfsdk:"bookmark_id"`
+	Title      types.String `tfsdk:"title"`
+	Link       types.String `tfsdk:"link"`
+	Emoji      types.String `tfsdk:"emoji"`
+	Type       types.String `tfsdk:"type"`
+}
+
+// Metadata returns the resource type name.
+func (r *ConversationBookmarkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_conversation_bookmark"
+}
+
+// Schema defines the schema for the resource.
+func (r *ConversationBookmarkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a bookmark of a Slack conversation",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The resource ID, in the form `<channel_id>/<bookmark_id>`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the conversation the bookmark belongs to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"bookmark_id": schema.StringAttribute{
+				MarkdownDescription: "The bookmark ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "The title of the bookmark",
+				Required:            true,
+			},
+			"link": schema.StringAttribute{
+				MarkdownDescription: "The URL the bookmark points to",
+				Required:            true,
+			},
+			"emoji": schema.StringAttribute{
+				MarkdownDescription: "The emoji displayed next to the bookmark, e.g. `:book:`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the bookmark. Only 'link' is supported. Default is 'link'.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("link"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("link"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ConversationBookmarkResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *slack.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create adds a bookmark to a Slack conversation.
+func (r *ConversationBookmarkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ConversationBookmarkResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bookmark, err := r.client.AddBookmarkContext(ctx, data.ChannelID.ValueString(), slack.AddBookmarkParameters{
+		Title: data.Title.ValueString(),
+		Type:  data.Type.ValueString(),
+		Link:  data.Link.ValueString(),
+		Emoji: data.Emoji.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add bookmark: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(bookmarkResourceID(data.ChannelID.ValueString(), bookmark.ID))
+	data.BookmarkID = types.StringValue(bookmark.ID)
+	data.applyBookmark(bookmark)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read reads the current state of a Slack conversation bookmark.
+func (r *ConversationBookmarkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ConversationBookmarkResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bookmarks, err := r.client.ListBookmarksContext(ctx, data.ChannelID.ValueString())
+	if err != nil {
+		if err.Error() == errChannelNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list bookmarks: %s", err))
+		return
+	}
+
+	// Bookmarks deleted from the Slack UI are simply missing from the list
+	found := false
+	for _, bookmark := range bookmarks {
+		if bookmark.ID == data.BookmarkID.ValueString() {
+			data.applyBookmark(bookmark)
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update edits a Slack conversation bookmark.
+func (r *ConversationBookmarkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ConversationBookmarkResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	title := data.Title.ValueString()
+	// An empty emoji clears the one previously set
+	emoji := data.Emoji.ValueString()
+
+	bookmark, err := r.client.EditBookmarkContext(ctx, state.ChannelID.ValueString(), state.BookmarkID.ValueString(), slack.EditBookmarkParameters{
+		Title: &title,
+		Emoji: &emoji,
+		Link:  data.Link.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to edit bookmark: %s", err))
+		return
+	}
+
+	data.ID = state.ID
+	data.BookmarkID = state.BookmarkID
+	data.applyBookmark(bookmark)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes a bookmark from a Slack conversation.
+func (r *ConversationBookmarkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ConversationBookmarkResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemoveBookmarkContext(ctx, data.ChannelID.ValueString(), data.BookmarkID.ValueString())
+	if err != nil && err.Error() != errNotFound && err.Error() != errChannelNotFound {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove bookmark: %s", err))
+		return
+	}
+}
+
+// ImportState imports a Slack conversation bookmark using `<channel_id>/<bookmark_id>`.
+func (r *ConversationBookmarkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <channel_id>/<bookmark_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("bookmark_id"), parts[1])...)
+}
+
+// applyBookmark copies the attributes returned by Slack into the model.
+func (m *ConversationBookmarkResourceModel) applyBookmark(bookmark slack.Bookmark) {
+	m.Title = types.StringValue(bookmark.Title)
+	m.Link = types.StringValue(bookmark.Link)
+	m.Type = types.StringValue(bookmark.Type)
+
+	// Slack may return the emoji with or without the surrounding colons,
+	// keep the configured spelling when both designate the same emoji.
+	switch {
+	case bookmark.Emoji == "":
+		m.Emoji = types.StringNull()
+	case strings.Trim(bookmark.Emoji, ":") != strings.Trim(m.Emoji.ValueString(), ":"):
+		m.Emoji = types.StringValue(bookmark.Emoji)
+	}
+}
+
+// bookmarkResourceID builds the ID of a conversation bookmark resource.
+func bookmarkResourceID(channelID, bookmarkID string) string {
+	return channelID + "/" + bookmarkID
+}
@@ -0,0 +1,93 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccSlackConversationBookmarkTest(t *testing.T) {
+	if os.Getenv("TF_ACC") != "1" {
+		t.Skip("Acceptance tests skipped unless env 'TF_ACC' is set to 1")
+		return
+	}
+	t.Parallel()
+
+	resourceName := "slack_conversation_bookmark.test"
+
+	t.Run("update title, link and emoji", func(t *testing.T) {
+		channel := createTestConversation(t)
+
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			CheckDestroy:             testAccCheckConversationBookmarkDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccSlackConversationBookmarkConfig(channel.ID, "Runbook", "https://example.com/runbook", ":book:"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "channel_id", channel.ID),
+						resource.TestCheckResourceAttr(resourceName, "title", "Runbook"),
+						resource.TestCheckResourceAttr(resourceName, "link", "https://example.com/runbook"),
+						resource.TestCheckResourceAttr(resourceName, "emoji", ":book:"),
+						resource.TestCheckResourceAttr(resourceName, "type", "link"),
+						resource.TestCheckResourceAttrSet(resourceName, "bookmark_id"),
+					),
+				},
+				{
+					ResourceName:      resourceName,
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+				{
+					Config: testAccSlackConversationBookmarkConfig(channel.ID, "Dashboard", "https://example.com/dashboard", ":bar_chart:"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "title", "Dashboard"),
+						resource.TestCheckResourceAttr(resourceName, "link", "https://example.com/dashboard"),
+						resource.TestCheckResourceAttr(resourceName, "emoji", ":bar_chart:"),
+					),
+				},
+			},
+		})
+	})
+}
+
+func testAccCheckConversationBookmarkDestroy(s *terraform.State) error {
+	c := getTestSlackClient()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "slack_conversation_bookmark" {
+			continue
+		}
+
+		bookmarks, err := c.ListBookmarksContext(context.Background(), rs.Primary.Attributes["channel_id"])
+		if err != nil {
+			if err.Error() == errChannelNotFound {
+				continue
+			}
+			return fmt.Errorf("error listing bookmarks of %s: %s", rs.Primary.Attributes["channel_id"], err)
+		}
+
+		for _, bookmark := range bookmarks {
+			if bookmark.ID == rs.Primary.Attributes["bookmark_id"] {
+				return fmt.Errorf("bookmark %s still exists", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccSlackConversationBookmarkConfig(channelID, title, link, emoji string) string {
+	return fmt.Sprintf(`
+resource slack_conversation_bookmark test {
+  channel_id = "%s"
+  title      = "%s"
+  link       = "%s"
+  emoji      = "%s"
+}
+`, channelID, title, link, emoji)
+}
@@ -0,0 +1,334 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/slack-go/slack"
+)
+
+const (
+	errMessageNotFound = "message_not_found"
+	errAlreadyPinned   = "already_pinned"
+	errNoPin           = "no_pin"
+	errThreadNotFound  = "thread_not_found"
+)
+
+var _ resource.Resource = &ConversationPinnedMessageResource{}
+
+// NewConversationPinnedMessageResource creates a new Slack conversation pinned message resource.
+func NewConversationPinnedMessageResource() resource.Resource {
+	return &ConversationPinnedMessageResource{}
+}
+
+// ConversationPinnedMessageResource implements the Slack conversation pinned message resource.
+type ConversationPinnedMessageResource struct {
+	client *Client
+}
+
+// ConversationPinnedMessageResourceModel describes the conversation pinned message resource data model.
+type ConversationPinnedMessageResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	ChannelID types.String `tfsdk:"channel_id"`
+	Text      types.String `tfsdk:"text"`
+	Blocks    types.String `tfsdk:"blocks"`
+	TS        types.String `tfsdk:"ts"`
+	Pinned    types.Bool   `tfsdk:"pinned"`
+}
+
+// Metadata returns the resource type name.
+func (r *ConversationPinnedMessageResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_conversation_pinned_message"
+}
+
+// Schema defines the schema for the resource.
+func (r *ConversationPinnedMessageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a message posted and pinned in a Slack conversation",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The resource ID, in the form `<channel_id>/<ts>`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the conversation to post the message in",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"text": schema.StringAttribute{
+				MarkdownDescription: "The text of the message. When `blocks` is set, it is used as the notification fallback.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("blocks")),
+				},
+			},
+			"blocks": schema.StringAttribute{
+				MarkdownDescription: "The Block Kit blocks of the message, as a JSON encoded array",
+				Optional:            true,
+				Validators: []validator.String{
+					blocksValidator{},
+				},
+			},
+			"ts": schema.StringAttribute{
+				MarkdownDescription: "The timestamp identifying the message in the conversation",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pinned": schema.BoolAttribute{
+				MarkdownDescription: "Whether the message is pinned. It is false when the message was unpinned from the Slack UI, and the next apply pins it again.",
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ConversationPinnedMessageResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *slack.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create posts the message and pins it to the conversation.
+func (r *ConversationPinnedMessageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ConversationPinnedMessageResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options, err := messageContentOptions(data.Text, data.Blocks, false)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Blocks", err.Error())
+		return
+	}
+
+	channelID := data.ChannelID.ValueString()
+	_, ts, err := r.client.PostMessageContext(ctx, channelID, options...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to post message: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(channelID + "/" + ts)
+	data.TS = types.StringValue(ts)
+
+	// Save the message right away so that it is cleaned up if pinning fails
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.AddPinContext(ctx, channelID, slack.NewRefToMessage(channelID, ts)); err != nil && err.Error() != errAlreadyPinned {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to pin message: %s", err))
+		return
+	}
+}
+
+// Read checks that the message still exists and is still pinned.
+func (r *ConversationPinnedMessageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ConversationPinnedMessageResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channelID := data.ChannelID.ValueString()
+	message, err := findMessage(ctx, r.client, channelID, data.TS.ValueString(), "")
+	if err != nil {
+		if err.Error() == errChannelNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read message: %s", err))
+		return
+	}
+
+	// A message deleted from the Slack UI is posted again on the next apply,
+	// a message unpinned is pinned again
+	if message == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Pinned = types.BoolValue(contains(message.PinnedTo, channelID))
+
+	// Slack escapes &, < and > in the text it returns
+	if !data.Text.IsNull() {
+		data.Text = types.StringValue(unescapeMessageText(message.Text))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update edits the pinned message in place, and pins it again when it was
+// unpinned from the Slack UI.
+func (r *ConversationPinnedMessageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ConversationPinnedMessageResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channelID := state.ChannelID.ValueString()
+	ts := state.TS.ValueString()
+
+	if !data.Text.Equal(state.Text) || !data.Blocks.Equal(state.Blocks) {
+		options, err := messageContentOptions(data.Text, data.Blocks, true)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Blocks", err.Error())
+			return
+		}
+
+		if _, _, _, err := r.client.UpdateMessageContext(ctx, channelID, ts, options...); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update message: %s", err))
+			return
+		}
+	}
+
+	if !state.Pinned.ValueBool() {
+		if err := r.client.AddPinContext(ctx, channelID, slack.NewRefToMessage(channelID, ts)); err != nil && err.Error() != errAlreadyPinned {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to pin message: %s", err))
+			return
+		}
+	}
+
+	data.ID = state.ID
+	data.TS = state.TS
+	data.Pinned = types.BoolValue(true)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete unpins and deletes the message.
+func (r *ConversationPinnedMessageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ConversationPinnedMessageResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channelID := data.ChannelID.ValueString()
+	ts := data.TS.ValueString()
+
+	if err := r.client.RemovePinContext(ctx, channelID, slack.NewRefToMessage(channelID, ts)); err != nil {
+		errStr := err.Error()
+		if errStr != errNoPin && errStr != errMessageNotFound && errStr != errChannelNotFound {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to unpin message: %s", err))
+			return
+		}
+	}
+
+	if _, _, err := r.client.DeleteMessageContext(ctx, channelID, ts); err != nil {
+		if err.Error() != errMessageNotFound && err.Error() != errChannelNotFound {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete message: %s", err))
+			return
+		}
+	}
+}
+
+// messageContentOptions builds the chat.postMessage and chat.update options
+// for the configured text and blocks. When updating, the blocks are always
+// sent so that removing them from the configuration clears them in Slack.
+func messageContentOptions(text, blocks types.String, update bool) ([]slack.MsgOption, error) {
+	options := []slack.MsgOption{
+		slack.MsgOptionText(text.ValueString(), false),
+	}
+
+	if blocks.IsNull() {
+		if update {
+			options = append(options, slack.MsgOptionBlocks([]slack.Block{}...))
+		}
+		return options, nil
+	}
+
+	parsed, err := parseBlocks(blocks.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	return append(options, slack.MsgOptionBlocks(parsed...)), nil
+}
+
+// findMessage returns the message of the conversation posted at ts, or nil
+// when it does not exist anymore. Replies are looked up in the thread started
+// at threadTS.
+func findMessage(ctx context.Context, client *Client, channelID, ts, threadTS string) (*slack.Message, error) {
+	var messages []slack.Message
+	if threadTS != "" && threadTS != ts {
+		replies, _, _, err := client.GetConversationRepliesContext(ctx, &slack.GetConversationRepliesParameters{
+			ChannelID: channelID,
+			Timestamp: threadTS,
+			Latest:    ts,
+			Oldest:    ts,
+			Inclusive: true,
+		})
+		if err != nil {
+			if err.Error() == errThreadNotFound {
+				return nil, nil
+			}
+			return nil, err
+		}
+		messages = replies
+	} else {
+		history, err := client.GetConversationHistoryContext(ctx, &slack.GetConversationHistoryParameters{
+			ChannelID: channelID,
+			Latest:    ts,
+			Oldest:    ts,
+			Inclusive: true,
+			Limit:     1,
+		})
+		if err != nil {
+			return nil, err
+		}
+		messages = history.Messages
+	}
+
+	for _, message := range messages {
+		if message.Timestamp == ts {
+			return &message, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// unescapeMessageText reverts the escaping Slack applies to message text.
+func unescapeMessageText(text string) string {
+	return strings.NewReplacer("&amp;", "&", "&lt;", "<", "&gt;", ">").Replace(text)
+}
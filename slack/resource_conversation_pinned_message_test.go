@@ -0,0 +1,141 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/slack-go/slack"
+)
+
+func TestAccSlackConversationPinnedMessageTest(t *testing.T) {
+	if os.Getenv("TF_ACC") != "1" {
+		t.Skip("Acceptance tests skipped unless env 'TF_ACC' is set to 1")
+		return
+	}
+	t.Parallel()
+
+	resourceName := "slack_conversation_pinned_message.test"
+
+	t.Run("update text and blocks", func(t *testing.T) {
+		channel := createTestConversation(t)
+		blocks := `[{"type":"section","text":{"type":"mrkdwn","text":"*Read me first*"}}]`
+		var ts string
+
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			CheckDestroy:             testAccCheckConversationPinnedMessageDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccSlackConversationPinnedMessageConfig(channel.ID, "Read me first"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "channel_id", channel.ID),
+						resource.TestCheckResourceAttr(resourceName, "text", "Read me first"),
+						resource.TestCheckResourceAttrSet(resourceName, "ts"),
+						testCheckSlackMessagePinned(resourceName),
+					),
+				},
+				{
+					Config: testAccSlackConversationPinnedMessageConfigWithBlocks(channel.ID, "Read me first & foremost", blocks),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "text", "Read me first & foremost"),
+						resource.TestCheckResourceAttr(resourceName, "blocks", blocks),
+						resource.TestCheckResourceAttr(resourceName, "pinned", "true"),
+						testCheckSlackMessagePinned(resourceName),
+						func(s *terraform.State) error {
+							ts = s.RootModule().Resources[resourceName].Primary.Attributes["ts"]
+							return nil
+						},
+					),
+				},
+				{
+					// A message unpinned from the Slack UI is pinned again,
+					// without posting it again
+					PreConfig: func() {
+						if err := getTestSlackClient().RemovePin(channel.ID, slack.NewRefToMessage(channel.ID, ts)); err != nil {
+							t.Fatalf("error unpinning message: %s", err)
+						}
+					},
+					Config: testAccSlackConversationPinnedMessageConfigWithBlocks(channel.ID, "Read me first & foremost", blocks),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttrPtr(resourceName, "ts", &ts),
+						resource.TestCheckResourceAttr(resourceName, "pinned", "true"),
+						testCheckSlackMessagePinned(resourceName),
+					),
+				},
+			},
+		})
+	})
+}
+
+func testCheckSlackMessagePinned(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		channelID := rs.Primary.Attributes["channel_id"]
+		items, _, err := getTestSlackClient().ListPinsContext(context.Background(), channelID)
+		if err != nil {
+			return fmt.Errorf("couldn't list pins of %s: %s", channelID, err)
+		}
+
+		for _, item := range items {
+			if item.Message != nil && item.Message.Timestamp == rs.Primary.Attributes["ts"] {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("message %s is not pinned", rs.Primary.ID)
+	}
+}
+
+func testAccCheckConversationPinnedMessageDestroy(s *terraform.State) error {
+	c := getTestSlackClient()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "slack_conversation_pinned_message" {
+			continue
+		}
+
+		channelID := rs.Primary.Attributes["channel_id"]
+		items, _, err := c.ListPinsContext(context.Background(), channelID)
+		if err != nil {
+			if err.Error() == errChannelNotFound {
+				continue
+			}
+			return fmt.Errorf("error listing pins of %s: %s", channelID, err)
+		}
+
+		for _, item := range items {
+			if item.Message != nil && item.Message.Timestamp == rs.Primary.Attributes["ts"] {
+				return fmt.Errorf("message %s is still pinned", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccSlackConversationPinnedMessageConfig(channelID, text string) string {
+	return fmt.Sprintf(`
+resource slack_conversation_pinned_message test {
+  channel_id = "%s"
+  text       = "%s"
+}
+`, channelID, text)
+}
+
+func testAccSlackConversationPinnedMessageConfigWithBlocks(channelID, text, blocks string) string {
+	return fmt.Sprintf(`
+resource slack_conversation_pinned_message test {
+  channel_id = "%s"
+  text       = "%s"
+  blocks     = %q
+}
+`, channelID, text, blocks)
+}
@@ -0,0 +1,400 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/slack-go/slack"
+)
+
+var _ resource.Resource = &ConversationPrefsResource{}
+var _ resource.ResourceWithImportState = &ConversationPrefsResource{}
+
+// NewConversationPrefsResource creates a new Slack conversation prefs resource.
+func NewConversationPrefsResource() resource.Resource {
+	return &ConversationPrefsResource{}
+}
+
+// ConversationPrefsResource implements the Slack conversation prefs resource.
+type ConversationPrefsResource struct {
+	client *Client
+}
+
+// ConversationPrefsResourceModel describes the conversation prefs resource data model.
+type ConversationPrefsResourceModel struct {
+	ID                 types.String                         `tfsdk:"id"`
+	ChannelID          types.String                         `tfsdk:"channel_id"`
+	PostingPermissions *ConversationPostingPermissionsModel `tfsdk:"posting_permissions"`
+	RetentionDays      types.Int64                          `tfsdk:"retention_days"`
+}
+
+// ConversationPostingPermissionsModel describes who can post in a conversation.
+type ConversationPostingPermissionsModel struct {
+	WhoCanPost *ConversationPrefModel `tfsdk:"who_can_post"`
+	CanThread  *ConversationPrefModel `tfsdk:"can_thread"`
+}
+
+// ConversationPrefModel describes the user types and users a conversation
+// pref applies to.
+type ConversationPrefModel struct {
+	Types types.Set `tfsdk:"types"`
+	Users types.Set `tfsdk:"users"`
+}
+
+// conversationPref is a pref returned by admin.conversations.getConversationPrefs.
+type conversationPref struct {
+	Type []string `json:"type"`
+	User []string `json:"user"`
+}
+
+// conversationPrefsResponse is the admin.conversations.getConversationPrefs answer.
+type conversationPrefsResponse struct {
+	Prefs struct {
+		WhoCanPost conversationPref `json:"who_can_post"`
+		CanThread  conversationPref `json:"can_thread"`
+	} `json:"prefs"`
+	slack.SlackResponse
+}
+
+// customRetentionResponse is the admin.conversations.getCustomRetention answer.
+type customRetentionResponse struct {
+	IsPolicyEnabled bool `json:"is_policy_enabled"`
+	DurationDays    int  `json:"duration_days"`
+	slack.SlackResponse
+}
+
+// Metadata returns the resource type name.
+func (r *ConversationPrefsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_conversation_prefs"
+}
+
+// conversationPrefSchema returns the schema of a single conversation pref.
+func conversationPrefSchema(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"types": schema.SetAttribute{
+				MarkdownDescription: "The user types allowed, among `admin`, `regular`, `ra` (guests) and `ee` (external users)",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf("admin", "regular", "ra", "ee")),
+				},
+			},
+			"users": schema.SetAttribute{
+				MarkdownDescription: "IDs of users allowed in addition to the user types. Default is empty.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+		},
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *ConversationPrefsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	whoCanPost := conversationPrefSchema("Who can post messages")
+	whoCanPost.Validators = []validator.Object{
+		objectvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("can_thread")),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the admin settings of a Slack conversation: who can post and the retention period",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The conversation ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the conversation",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"posting_permissions": schema.SingleNestedAttribute{
+				MarkdownDescription: "Who can post in the conversation",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"who_can_post": whoCanPost,
+					"can_thread":   conversationPrefSchema("Who can reply in threads"),
+				},
+			},
+			"retention_days": schema.Int64Attribute{
+				MarkdownDescription: "The number of days messages are kept in the conversation. The workspace retention policy applies when unset.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ConversationPrefsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *slack.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create applies the configured settings.
+func (r *ConversationPrefsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ConversationPrefsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.applyPrefs(ctx, data, types.Int64Null()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set conversation prefs: %s", err))
+		return
+	}
+
+	data.ID = data.ChannelID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read reads back the managed settings.
+func (r *ConversationPrefsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ConversationPrefsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the ID is known after an import
+	imported := data.ChannelID.IsNull()
+
+	channelID := data.ID.ValueString()
+	data.ChannelID = data.ID
+
+	var prefs conversationPrefsResponse
+	if err := r.client.callMethod(ctx, "admin.conversations.getConversationPrefs", url.Values{"channel_id": {channelID}}, &prefs); err != nil {
+		if err.Error() == errChannelNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read conversation prefs: %s", err))
+		return
+	}
+
+	if imported && data.PostingPermissions == nil {
+		data.PostingPermissions = importedPostingPermissions(prefs)
+	}
+
+	if data.PostingPermissions != nil {
+		if data.PostingPermissions.WhoCanPost != nil {
+			resp.Diagnostics.Append(data.PostingPermissions.WhoCanPost.apply(ctx, prefs.Prefs.WhoCanPost)...)
+		}
+		if data.PostingPermissions.CanThread != nil {
+			resp.Diagnostics.Append(data.PostingPermissions.CanThread.apply(ctx, prefs.Prefs.CanThread)...)
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var retention customRetentionResponse
+	if err := r.client.callMethod(ctx, "admin.conversations.getCustomRetention", url.Values{"channel_id": {channelID}}, &retention); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read conversation retention: %s", err))
+		return
+	}
+
+	if retention.IsPolicyEnabled {
+		data.RetentionDays = types.Int64Value(int64(retention.DurationDays))
+	} else {
+		data.RetentionDays = types.Int64Null()
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update applies the changed settings.
+func (r *ConversationPrefsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ConversationPrefsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.applyPrefs(ctx, data, state.RetentionDays); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set conversation prefs: %s", err))
+		return
+	}
+
+	data.ID = state.ID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the custom retention period. Posting permissions are left
+// as they are since Slack has no way to reset them.
+func (r *ConversationPrefsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ConversationPrefsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.RetentionDays.IsNull() {
+		return
+	}
+
+	err := r.client.callMethod(ctx, "admin.conversations.removeCustomRetention", url.Values{"channel_id": {data.ID.ValueString()}}, &slack.SlackResponse{})
+	if err != nil && err.Error() != errChannelNotFound {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove conversation retention: %s", err))
+		return
+	}
+}
+
+// ImportState imports the settings of a Slack conversation using its ID.
+func (r *ConversationPrefsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// applyPrefs sends the posting permissions and the retention period of data.
+// The custom retention is removed when it was set, as previousRetention, and
+// is not configured anymore.
+func (r *ConversationPrefsResource) applyPrefs(ctx context.Context, data ConversationPrefsResourceModel, previousRetention types.Int64) error {
+	channelID := data.ChannelID.ValueString()
+
+	if data.PostingPermissions != nil {
+		prefs := map[string]string{}
+		if data.PostingPermissions.WhoCanPost != nil {
+			value, err := data.PostingPermissions.WhoCanPost.encode(ctx)
+			if err != nil {
+				return err
+			}
+			prefs["who_can_post"] = value
+		}
+		if data.PostingPermissions.CanThread != nil {
+			value, err := data.PostingPermissions.CanThread.encode(ctx)
+			if err != nil {
+				return err
+			}
+			prefs["can_thread"] = value
+		}
+
+		encoded, err := json.Marshal(prefs)
+		if err != nil {
+			return err
+		}
+
+		values := url.Values{
+			"channel_id": {channelID},
+			"prefs":      {string(encoded)},
+		}
+		if err := r.client.callMethod(ctx, "admin.conversations.setConversationPrefs", values, &slack.SlackResponse{}); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case !data.RetentionDays.IsNull() && !data.RetentionDays.Equal(previousRetention):
+		values := url.Values{
+			"channel_id":    {channelID},
+			"duration_days": {strconv.FormatInt(data.RetentionDays.ValueInt64(), 10)},
+		}
+		return r.client.callMethod(ctx, "admin.conversations.setCustomRetention", values, &slack.SlackResponse{})
+	case data.RetentionDays.IsNull() && !previousRetention.IsNull():
+		return r.client.callMethod(ctx, "admin.conversations.removeCustomRetention", url.Values{"channel_id": {channelID}}, &slack.SlackResponse{})
+	}
+
+	return nil
+}
+
+// encode formats the pref as expected by admin.conversations.setConversationPrefs,
+// e.g. `type:admin,user:U0123456`.
+func (m *ConversationPrefModel) encode(ctx context.Context) (string, error) {
+	var userTypes, users []string
+	if diags := m.Types.ElementsAs(ctx, &userTypes, false); diags.HasError() {
+		return "", fmt.Errorf("unable to read types")
+	}
+	if diags := m.Users.ElementsAs(ctx, &users, false); diags.HasError() {
+		return "", fmt.Errorf("unable to read users")
+	}
+	sort.Strings(userTypes)
+	sort.Strings(users)
+
+	parts := make([]string, 0, len(userTypes)+len(users))
+	for _, userType := range userTypes {
+		parts = append(parts, "type:"+userType)
+	}
+	for _, user := range users {
+		parts = append(parts, "user:"+user)
+	}
+
+	return strings.Join(parts, ","), nil
+}
+
+// importedPostingPermissions returns the posting permissions to import, with
+// the prefs set on the conversation, or nil when none is set.
+func importedPostingPermissions(prefs conversationPrefsResponse) *ConversationPostingPermissionsModel {
+	var permissions ConversationPostingPermissionsModel
+	if len(prefs.Prefs.WhoCanPost.Type) > 0 || len(prefs.Prefs.WhoCanPost.User) > 0 {
+		permissions.WhoCanPost = &ConversationPrefModel{}
+	}
+	if len(prefs.Prefs.CanThread.Type) > 0 || len(prefs.Prefs.CanThread.User) > 0 {
+		permissions.CanThread = &ConversationPrefModel{}
+	}
+
+	if permissions.WhoCanPost == nil && permissions.CanThread == nil {
+		return nil
+	}
+	return &permissions
+}
+
+// apply copies the pref returned by Slack into the model.
+func (m *ConversationPrefModel) apply(ctx context.Context, pref conversationPref) (diags diag.Diagnostics) {
+	userTypes, d := types.SetValueFrom(ctx, types.StringType, nonNilStrings(pref.Type))
+	diags.Append(d...)
+	users, d := types.SetValueFrom(ctx, types.StringType, nonNilStrings(pref.User))
+	diags.Append(d...)
+
+	m.Types = userTypes
+	m.Users = users
+	return diags
+}
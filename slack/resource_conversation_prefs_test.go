@@ -0,0 +1,70 @@
+package slack
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSlackConversationPrefsTest(t *testing.T) {
+	if os.Getenv("TF_ACC") != "1" {
+		t.Skip("Acceptance tests skipped unless env 'TF_ACC' is set to 1")
+		return
+	}
+	testAccPreCheckAdmin(t)
+
+	resourceName := "slack_conversation_prefs.test"
+	channel := createTestConversation(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackConversationPrefsConfig(channel.ID, `["admin"]`, 90),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", channel.ID),
+					resource.TestCheckResourceAttr(resourceName, "posting_permissions.who_can_post.types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "posting_permissions.who_can_post.types.*", "admin"),
+					resource.TestCheckResourceAttr(resourceName, "posting_permissions.who_can_post.users.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "posting_permissions.can_thread.types.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "retention_days", "90"),
+				),
+			},
+			{
+				Config: testAccSlackConversationPrefsConfig(channel.ID, `["admin", "regular"]`, 365),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "posting_permissions.who_can_post.types.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "retention_days", "365"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSlackConversationPrefsConfig(channelID, whoCanPost string, retentionDays int) string {
+	return fmt.Sprintf(`
+resource slack_conversation_prefs test {
+  channel_id = "%s"
+
+  posting_permissions = {
+    who_can_post = {
+      types = %s
+      users = ["%s"]
+    }
+    can_thread = {
+      types = ["admin", "regular"]
+    }
+  }
+
+  retention_days = %d
+}
+`, channelID, whoCanPost, testUser00.id, retentionDays)
+}
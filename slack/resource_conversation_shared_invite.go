@@ -0,0 +1,351 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/slack-go/slack"
+)
+
+const (
+	sharedInviteStatusPending  = "pending"
+	sharedInviteStatusAccepted = "accepted"
+)
+
+var _ resource.Resource = &ConversationSharedInviteResource{}
+
+// NewConversationSharedInviteResource creates a new Slack Connect invite resource.
+func NewConversationSharedInviteResource() resource.Resource {
+	return &ConversationSharedInviteResource{}
+}
+
+// ConversationSharedInviteResource implements the Slack Connect invite resource.
+type ConversationSharedInviteResource struct {
+	client *Client
+}
+
+// ConversationSharedInviteResourceModel describes the Slack Connect invite resource data model.
+type ConversationSharedInviteResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	ChannelID       types.String `tfsdk:"channel_id"`
+	Email           types.String `tfsdk:"email"`
+	UserID          types.String `tfsdk:"user_id"`
+	ExternalLimited types.Bool   `tfsdk:"external_limited"`
+	InviteID        types.String `tfsdk:"invite_id"`
+	Status          types.String `tfsdk:"status"`
+	AcceptingTeamID types.String `tfsdk:"accepting_team_id"`
+}
+
+// connectInvite is an invite returned by conversations.listConnectInvites.
+type connectInvite struct {
+	Invite struct {
+		ID string `json:"id"`
+	} `json:"invite"`
+	Acceptances []struct {
+		AcceptingTeam struct {
+			ID string `json:"id"`
+		} `json:"accepting_team"`
+	} `json:"acceptances"`
+}
+
+// listConnectInvitesResponse is the conversations.listConnectInvites answer.
+type listConnectInvitesResponse struct {
+	Invites          []connectInvite        `json:"invites"`
+	ResponseMetadata slack.ResponseMetadata `json:"response_metadata"`
+	slack.SlackResponse
+}
+
+// Metadata returns the resource type name.
+func (r *ConversationSharedInviteResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_conversation_shared_invite"
+}
+
+// Schema defines the schema for the resource.
+func (r *ConversationSharedInviteResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Slack Connect invitation to share a channel with an external organization",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The resource ID, in the form `<channel_id>/<invite_id>`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the channel to share",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email address of the person to invite",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("user_id")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the external user to invite",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"external_limited": schema.BoolAttribute{
+				MarkdownDescription: "Whether the invited organization is prevented from inviting more organizations. Slack defaults to true.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"invite_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the invitation",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "`pending` until the invitation is accepted, then `accepted`",
+				Computed:            true,
+			},
+			"accepting_team_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the organization which accepted the invitation",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ConversationSharedInviteResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *slack.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create sends the Slack Connect invitation.
+func (r *ConversationSharedInviteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ConversationSharedInviteResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := slack.InviteSharedToConversationParams{
+		ChannelID: data.ChannelID.ValueString(),
+	}
+	if !data.Email.IsNull() {
+		params.Emails = []string{data.Email.ValueString()}
+	} else {
+		params.UserIDs = []string{data.UserID.ValueString()}
+	}
+	if !data.ExternalLimited.IsNull() {
+		externalLimited := data.ExternalLimited.ValueBool()
+		params.ExternalLimited = &externalLimited
+	}
+
+	inviteID, _, err := r.client.InviteSharedToConversationContext(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to invite to shared channel: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(data.ChannelID.ValueString() + "/" + inviteID)
+	data.InviteID = types.StringValue(inviteID)
+	data.Status = types.StringValue(sharedInviteStatusPending)
+	data.AcceptingTeamID = types.StringNull()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read tracks whether the invitation was accepted.
+func (r *ConversationSharedInviteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ConversationSharedInviteResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	invite, err := findConnectInvite(ctx, r.client, data.InviteID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list Slack Connect invites: %s", err))
+		return
+	}
+
+	if invite != nil {
+		data.Status = types.StringValue(sharedInviteStatusPending)
+		data.AcceptingTeamID = types.StringNull()
+		for _, acceptance := range invite.Acceptances {
+			if acceptance.AcceptingTeam.ID != "" {
+				data.Status = types.StringValue(sharedInviteStatusAccepted)
+				data.AcceptingTeamID = types.StringValue(acceptance.AcceptingTeam.ID)
+				break
+			}
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	// Only pending invitations are listed: once accepted, the invited
+	// organization shows up in the channel connected teams. Invitations
+	// revoked or declined from the Slack UI are not listed either, so an
+	// invitation whose accepting organization was never seen is dropped.
+	channel, err := r.client.GetConversationInfoContext(ctx, &slack.GetConversationInfoInput{
+		ChannelID: data.ChannelID.ValueString(),
+	})
+	if err != nil {
+		if err.Error() == errChannelNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read conversation %s: %s", data.ChannelID.ValueString(), err))
+		return
+	}
+
+	teamID := acceptingTeamID(channel, data.AcceptingTeamID.ValueString())
+	if teamID == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Status = types.StringValue(sharedInviteStatusAccepted)
+	data.AcceptingTeamID = types.StringValue(teamID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never called as every argument requires a replacement.
+func (r *ConversationSharedInviteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ConversationSharedInviteResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete disconnects the organization which accepted the invitation.
+func (r *ConversationSharedInviteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ConversationSharedInviteResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var teamIDs []string
+	if !data.AcceptingTeamID.IsNull() {
+		teamIDs = append(teamIDs, data.AcceptingTeamID.ValueString())
+	} else {
+		// The invitation may have been accepted since the last refresh
+		invite, err := findConnectInvite(ctx, r.client, data.InviteID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list Slack Connect invites: %s", err))
+			return
+		}
+		if invite == nil {
+			return
+		}
+
+		for _, acceptance := range invite.Acceptances {
+			if acceptance.AcceptingTeam.ID != "" {
+				teamIDs = append(teamIDs, acceptance.AcceptingTeam.ID)
+			}
+		}
+	}
+
+	if len(teamIDs) == 0 {
+		resp.Diagnostics.AddWarning(
+			"Pending Invitation Not Revoked",
+			fmt.Sprintf("The Web API has no method to revoke the pending invitation %s, it must be revoked from the channel settings in Slack.", data.InviteID.ValueString()),
+		)
+		return
+	}
+
+	for _, teamID := range teamIDs {
+		values := url.Values{
+			"channel_id":       {data.ChannelID.ValueString()},
+			"leaving_team_ids": {teamID},
+		}
+		err := r.client.callMethod(ctx, "admin.conversations.disconnectShared", values, &slack.SlackResponse{})
+		if err != nil && err.Error() != errChannelNotFound {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to disconnect %s from shared channel: %s", teamID, err))
+			return
+		}
+	}
+}
+
+// findConnectInvite returns the Slack Connect invite with the given ID, or
+// nil when it is not listed anymore.
+func findConnectInvite(ctx context.Context, client *Client, inviteID string) (*connectInvite, error) {
+	cursor := ""
+	for {
+		values := url.Values{"count": {"200"}}
+		if cursor != "" {
+			values.Set("cursor", cursor)
+		}
+
+		var page listConnectInvitesResponse
+		if err := client.callMethod(ctx, "conversations.listConnectInvites", values, &page); err != nil {
+			return nil, err
+		}
+
+		for i, invite := range page.Invites {
+			if invite.Invite.ID == inviteID {
+				return &page.Invites[i], nil
+			}
+		}
+
+		cursor = page.ResponseMetadata.Cursor
+		if cursor == "" {
+			return nil, nil
+		}
+	}
+}
+
+// acceptingTeamID returns the organization known to have accepted the
+// invitation when it is still connected to the shared channel, or "".
+// Other external organizations may be connected by other invitations, so
+// none of them is assumed to have accepted this one.
+func acceptingTeamID(channel *slack.Channel, known string) string {
+	if !channel.IsExtShared || known == "" || known == channel.ContextTeamID || contains(channel.InternalTeamIDs, known) {
+		return ""
+	}
+	if !contains(channel.ConnectedTeamIDs, known) {
+		return ""
+	}
+	return known
+}
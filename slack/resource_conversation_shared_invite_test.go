@@ -0,0 +1,49 @@
+package slack
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSlackConversationSharedInviteTest(t *testing.T) {
+	if os.Getenv("TF_ACC") != "1" {
+		t.Skip("Acceptance tests skipped unless env 'TF_ACC' is set to 1")
+		return
+	}
+	email := os.Getenv("SLACK_TEST_CONNECT_EMAIL")
+	if email == "" {
+		t.Skip("Slack Connect acceptance tests skipped unless env 'SLACK_TEST_CONNECT_EMAIL' is set")
+	}
+
+	resourceName := "slack_conversation_shared_invite.test"
+	channel := createTestConversation(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackConversationSharedInviteConfig(channel.ID, email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "channel_id", channel.ID),
+					resource.TestCheckResourceAttr(resourceName, "email", email),
+					resource.TestCheckResourceAttrSet(resourceName, "invite_id"),
+					resource.TestCheckResourceAttr(resourceName, "status", "pending"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSlackConversationSharedInviteConfig(channelID, email string) string {
+	return fmt.Sprintf(`
+resource slack_conversation_shared_invite test {
+  channel_id       = "%s"
+  email            = "%s"
+  external_limited = true
+}
+`, channelID, email)
+}
@@ -0,0 +1,281 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/slack-go/slack"
+)
+
+const (
+	errEmojiNotFound = "emoji_not_found"
+
+	// emojiAliasPrefix prefixes the value emoji.list returns for aliases.
+	emojiAliasPrefix = "alias:"
+)
+
+// emojiNameRegexp matches the names Slack accepts for custom emojis.
+var emojiNameRegexp = regexp.MustCompile(`^[a-z0-9_-]+$`)
+
+var _ resource.Resource = &EmojiResource{}
+var _ resource.ResourceWithImportState = &EmojiResource{}
+
+// NewEmojiResource creates a new Slack custom emoji resource.
+func NewEmojiResource() resource.Resource {
+	return &EmojiResource{}
+}
+
+// EmojiResource implements the Slack custom emoji resource.
+type EmojiResource struct {
+	client *Client
+}
+
+// EmojiResourceModel describes the emoji resource data model.
+type EmojiResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	URL      types.String `tfsdk:"url"`
+	AliasFor types.String `tfsdk:"alias_for"`
+	ImageURL types.String `tfsdk:"image_url"`
+}
+
+// Metadata returns the resource type name.
+func (r *EmojiResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_emoji"
+}
+
+// Schema defines the schema for the resource.
+func (r *EmojiResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a custom emoji of the Slack workspace",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The emoji name",
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the emoji, without the surrounding colons",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(emojiNameRegexp, "must only contain lowercase letters, digits, dashes and underscores"),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the image of the emoji",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("alias_for")),
+				},
+				PlanModifiers: []planmodifier.String{
+					// An imported emoji has no known source URL, setting it
+					// only records it in the state
+					stringplanmodifier.RequiresReplaceIf(func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+						resp.RequiresReplace = !req.StateValue.IsNull()
+					}, "Changing the URL adds a new emoji.", "Changing the URL adds a new emoji."),
+				},
+			},
+			"alias_for": schema.StringAttribute{
+				MarkdownDescription: "The name of the emoji this emoji is an alias for",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"image_url": schema.StringAttribute{
+				MarkdownDescription: "The URL Slack serves the image of the emoji from, null for aliases",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *EmojiResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *slack.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create adds the emoji, or the alias, to the workspace.
+func (r *EmojiResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data EmojiResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.addEmoji(ctx, data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add emoji: %s", err))
+		return
+	}
+
+	data.ID = data.Name
+	if err := r.setImageURL(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list emojis: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read checks that the emoji still exists.
+func (r *EmojiResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data EmojiResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	emojis, err := r.client.GetEmojiContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list emojis: %s", err))
+		return
+	}
+
+	value, ok := emojis[data.ID.ValueString()]
+	if !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Name = data.ID
+	if target, isAlias := strings.CutPrefix(value, emojiAliasPrefix); isAlias {
+		data.AliasFor = types.StringValue(target)
+		data.URL = types.StringNull()
+		data.ImageURL = types.StringNull()
+	} else {
+		// Slack serves the uploaded image from its own URL, so the configured
+		// URL is kept. It stays null for imported emojis.
+		data.AliasFor = types.StringNull()
+		data.ImageURL = types.StringValue(value)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update renames the emoji.
+func (r *EmojiResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state EmojiResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Name.Equal(state.Name) {
+		// Only emojis with an image can be renamed, aliases are added again
+		// under their new name
+		if !data.AliasFor.IsNull() {
+			if err := r.removeEmoji(ctx, state.Name.ValueString()); err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove emoji alias: %s", err))
+				return
+			}
+			if err := r.addEmoji(ctx, data); err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add emoji alias: %s", err))
+				return
+			}
+		} else {
+			values := url.Values{
+				"name":     {state.Name.ValueString()},
+				"new_name": {data.Name.ValueString()},
+			}
+			if err := r.client.callMethod(ctx, "admin.emoji.rename", values, &slack.SlackResponse{}); err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to rename emoji: %s", err))
+				return
+			}
+		}
+	}
+
+	data.ID = data.Name
+	if err := r.setImageURL(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list emojis: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the emoji from the workspace.
+func (r *EmojiResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data EmojiResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.removeEmoji(ctx, data.ID.ValueString()); err != nil && err.Error() != errEmojiNotFound {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove emoji: %s", err))
+		return
+	}
+}
+
+// ImportState imports a Slack custom emoji using its name.
+func (r *EmojiResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// addEmoji adds the emoji from its image URL, or as an alias of another emoji.
+func (r *EmojiResource) addEmoji(ctx context.Context, data EmojiResourceModel) error {
+	if !data.AliasFor.IsNull() {
+		values := url.Values{
+			"name":      {data.Name.ValueString()},
+			"alias_for": {data.AliasFor.ValueString()},
+		}
+		return r.client.callMethod(ctx, "admin.emoji.addAlias", values, &slack.SlackResponse{})
+	}
+
+	values := url.Values{
+		"name": {data.Name.ValueString()},
+		"url":  {data.URL.ValueString()},
+	}
+	return r.client.callMethod(ctx, "admin.emoji.add", values, &slack.SlackResponse{})
+}
+
+// removeEmoji removes the emoji, or the alias, named name.
+func (r *EmojiResource) removeEmoji(ctx context.Context, name string) error {
+	return r.client.callMethod(ctx, "admin.emoji.remove", url.Values{"name": {name}}, &slack.SlackResponse{})
+}
+
+// setImageURL sets the URL Slack serves the image of the emoji from.
+func (r *EmojiResource) setImageURL(ctx context.Context, data *EmojiResourceModel) error {
+	data.ImageURL = types.StringNull()
+	if !data.AliasFor.IsNull() {
+		return nil
+	}
+
+	emojis, err := r.client.GetEmojiContext(ctx)
+	if err != nil {
+		return err
+	}
+	if value, ok := emojis[data.Name.ValueString()]; ok {
+		data.ImageURL = types.StringValue(value)
+	}
+	return nil
+}
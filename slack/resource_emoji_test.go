@@ -0,0 +1,120 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	emojiNamePrefix = "tf-test-emoji"
+
+	// testEmojiImageURL is a square PNG image used as emoji in the tests.
+	testEmojiImageURL = "https://raw.githubusercontent.com/github/explore/main/topics/terraform/terraform.png"
+)
+
+func TestAccSlackEmojiTest(t *testing.T) {
+	if os.Getenv("TF_ACC") != "1" {
+		t.Skip("Acceptance tests skipped unless env 'TF_ACC' is set to 1")
+		return
+	}
+	testAccPreCheckAdmin(t)
+	t.Parallel()
+
+	resourceName := "slack_emoji.test"
+	aliasResourceName := "slack_emoji.alias"
+
+	t.Run("rename emoji and alias", func(t *testing.T) {
+		name := acctest.RandomWithPrefix(emojiNamePrefix)
+		newName := name + "-renamed"
+
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			CheckDestroy:             testAccCheckEmojiDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccSlackEmojiConfig(name),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "name", name),
+						resource.TestCheckResourceAttr(resourceName, "url", testEmojiImageURL),
+						resource.TestCheckResourceAttrSet(resourceName, "image_url"),
+						resource.TestCheckResourceAttr(aliasResourceName, "alias_for", name),
+						resource.TestCheckNoResourceAttr(aliasResourceName, "image_url"),
+					),
+				},
+				{
+					Config: testAccSlackEmojiConfig(newName),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "id", newName),
+						resource.TestCheckResourceAttr(aliasResourceName, "id", newName+"-alias"),
+						resource.TestCheckResourceAttr(aliasResourceName, "alias_for", newName),
+					),
+				},
+				{
+					ResourceName:            resourceName,
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"url"},
+				},
+				{
+					// The source URL is unknown to an imported emoji, so the
+					// configured one is recorded without adding the emoji again
+					ResourceName:       resourceName,
+					ImportState:        true,
+					ImportStatePersist: true,
+				},
+				{
+					Config: testAccSlackEmojiConfig(newName),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "url", testEmojiImageURL),
+					),
+				},
+			},
+		})
+	})
+}
+
+func testAccCheckEmojiDestroy(s *terraform.State) error {
+	emojis, err := getTestSlackClient().GetEmojiContext(context.Background())
+	if err != nil {
+		return fmt.Errorf("error listing emojis: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "slack_emoji" {
+			continue
+		}
+
+		if _, ok := emojis[rs.Primary.ID]; ok {
+			return fmt.Errorf("emoji %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccSlackEmojiConfig(name string) string {
+	return fmt.Sprintf(`
+resource slack_emoji test {
+  name = "%[1]s"
+  url  = "%[2]s"
+}
+
+resource slack_emoji alias {
+  name      = "%[1]s-alias"
+  alias_for = slack_emoji.test.name
+}
+`, name, testEmojiImageURL)
+}
@@ -0,0 +1,297 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/slack-go/slack"
+)
+
+const (
+	errBarrierNotFound = "barrier_not_found"
+)
+
+var _ resource.Resource = &InformationBarrierResource{}
+var _ resource.ResourceWithImportState = &InformationBarrierResource{}
+
+// NewInformationBarrierResource creates a new Slack information barrier resource.
+func NewInformationBarrierResource() resource.Resource {
+	return &InformationBarrierResource{}
+}
+
+// InformationBarrierResource implements the Slack information barrier resource.
+type InformationBarrierResource struct {
+	client *Client
+}
+
+// InformationBarrierResourceModel describes the information barrier resource data model.
+type InformationBarrierResourceModel struct {
+	ID                        types.String `tfsdk:"id"`
+	PrimaryUsergroupID        types.String `tfsdk:"primary_usergroup_id"`
+	BarrieredFromUsergroupIDs types.Set    `tfsdk:"barriered_from_usergroup_ids"`
+	RestrictedSubjects        types.Set    `tfsdk:"restricted_subjects"`
+}
+
+// informationBarrier is a barrier returned by admin.barriers.list.
+type informationBarrier struct {
+	ID               string `json:"id"`
+	PrimaryUsergroup struct {
+		ID string `json:"id"`
+	} `json:"primary_usergroup"`
+	BarrieredFromUsergroups []struct {
+		ID string `json:"id"`
+	} `json:"barriered_from_usergroups"`
+	RestrictedSubjects []string `json:"restricted_subjects"`
+}
+
+// listBarriersResponse is the admin.barriers.list answer.
+type listBarriersResponse struct {
+	Barriers         []informationBarrier   `json:"barriers"`
+	ResponseMetadata slack.ResponseMetadata `json:"response_metadata"`
+	slack.SlackResponse
+}
+
+// createBarrierResponse is the admin.barriers.create answer.
+type createBarrierResponse struct {
+	Barrier informationBarrier `json:"barrier"`
+	slack.SlackResponse
+}
+
+// Metadata returns the resource type name.
+func (r *InformationBarrierResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_information_barrier"
+}
+
+// Schema defines the schema for the resource.
+func (r *InformationBarrierResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an information barrier, which prevents the members of a usergroup from communicating with the members of other usergroups",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The barrier ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"primary_usergroup_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the IDP usergroup the barrier applies to",
+				Required:            true,
+			},
+			"barriered_from_usergroup_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the IDP usergroups the members of the primary usergroup cannot communicate with",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"restricted_subjects": schema.SetAttribute{
+				MarkdownDescription: "What is restricted between the usergroups, among `im`, `mpim` and `call`. Default is all of them.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default: setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{
+					types.StringValue("im"),
+					types.StringValue("mpim"),
+					types.StringValue("call"),
+				})),
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf("im", "mpim", "call")),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *InformationBarrierResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *slack.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the information barrier.
+func (r *InformationBarrierResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data InformationBarrierResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	values, diags := informationBarrierValues(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var created createBarrierResponse
+	if err := r.client.callMethod(ctx, "admin.barriers.create", values, &created); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create information barrier: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(created.Barrier.ID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read reads the information barrier.
+func (r *InformationBarrierResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data InformationBarrierResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	barrier, err := findInformationBarrier(ctx, r.client, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list information barriers: %s", err))
+		return
+	}
+	if barrier == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	usergroupIDs := make([]string, 0, len(barrier.BarrieredFromUsergroups))
+	for _, usergroup := range barrier.BarrieredFromUsergroups {
+		usergroupIDs = append(usergroupIDs, usergroup.ID)
+	}
+
+	barrieredFrom, diags := types.SetValueFrom(ctx, types.StringType, usergroupIDs)
+	resp.Diagnostics.Append(diags...)
+	restrictedSubjects, diags := types.SetValueFrom(ctx, types.StringType, nonNilStrings(barrier.RestrictedSubjects))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.PrimaryUsergroupID = types.StringValue(barrier.PrimaryUsergroup.ID)
+	data.BarrieredFromUsergroupIDs = barrieredFrom
+	data.RestrictedSubjects = restrictedSubjects
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the information barrier.
+func (r *InformationBarrierResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state InformationBarrierResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	values, diags := informationBarrierValues(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	values.Set("barrier_id", state.ID.ValueString())
+
+	if err := r.client.callMethod(ctx, "admin.barriers.update", values, &slack.SlackResponse{}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update information barrier: %s", err))
+		return
+	}
+
+	data.ID = state.ID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the information barrier.
+func (r *InformationBarrierResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data InformationBarrierResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	values := url.Values{"barrier_id": {data.ID.ValueString()}}
+	err := r.client.callMethod(ctx, "admin.barriers.delete", values, &slack.SlackResponse{})
+	if err != nil && err.Error() != errBarrierNotFound && err.Error() != errNotFound {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete information barrier: %s", err))
+		return
+	}
+}
+
+// ImportState imports an information barrier using its ID.
+func (r *InformationBarrierResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// informationBarrierValues returns the parameters shared by
+// admin.barriers.create and admin.barriers.update.
+func informationBarrierValues(ctx context.Context, data InformationBarrierResourceModel) (url.Values, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var usergroupIDs, restrictedSubjects []string
+	diags.Append(data.BarrieredFromUsergroupIDs.ElementsAs(ctx, &usergroupIDs, false)...)
+	diags.Append(data.RestrictedSubjects.ElementsAs(ctx, &restrictedSubjects, false)...)
+
+	return url.Values{
+		"primary_usergroup_id":         {data.PrimaryUsergroupID.ValueString()},
+		"barriered_from_usergroup_ids": {strings.Join(usergroupIDs, ",")},
+		"restricted_subjects":          {strings.Join(restrictedSubjects, ",")},
+	}, diags
+}
+
+// findInformationBarrier returns the information barrier with the given ID,
+// or nil when it does not exist anymore.
+func findInformationBarrier(ctx context.Context, client *Client, barrierID string) (*informationBarrier, error) {
+	cursor := ""
+	for {
+		values := url.Values{"limit": {"1000"}}
+		if cursor != "" {
+			values.Set("cursor", cursor)
+		}
+
+		var page listBarriersResponse
+		if err := client.callMethod(ctx, "admin.barriers.list", values, &page); err != nil {
+			return nil, err
+		}
+
+		for i, barrier := range page.Barriers {
+			if barrier.ID == barrierID {
+				return &page.Barriers[i], nil
+			}
+		}
+
+		cursor = page.ResponseMetadata.Cursor
+		if cursor == "" {
+			return nil, nil
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package slack
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSlackInformationBarrierTest(t *testing.T) {
+	if os.Getenv("TF_ACC") != "1" {
+		t.Skip("Acceptance tests skipped unless env 'TF_ACC' is set to 1")
+		return
+	}
+	testAccPreCheckAdmin(t)
+	usergroupIDs := strings.Split(os.Getenv("SLACK_TEST_IDP_USERGROUP_IDS"), ",")
+	if len(usergroupIDs) < 3 {
+		t.Skip("Information barrier acceptance tests skipped unless env 'SLACK_TEST_IDP_USERGROUP_IDS' lists 3 IDP usergroups")
+	}
+
+	resourceName := "slack_information_barrier.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackInformationBarrierConfig(usergroupIDs[0], usergroupIDs[1]),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "primary_usergroup_id", usergroupIDs[0]),
+					resource.TestCheckResourceAttr(resourceName, "barriered_from_usergroup_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "restricted_subjects.#", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSlackInformationBarrierConfig(usergroupIDs[0], usergroupIDs[1:]...),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "barriered_from_usergroup_ids.#", fmt.Sprint(len(usergroupIDs)-1)),
+				),
+			},
+		},
+	})
+}
+
+func testAccSlackInformationBarrierConfig(primaryID string, barrieredFromIDs ...string) string {
+	return fmt.Sprintf(`
+resource slack_information_barrier test {
+  primary_usergroup_id         = "%s"
+  barriered_from_usergroup_ids = ["%s"]
+}
+`, primaryID, strings.Join(barrieredFromIDs, `", "`))
+}
@@ -0,0 +1,293 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/slack-go/slack"
+)
+
+var _ resource.Resource = &MessageResource{}
+
+// NewMessageResource creates a new Slack message resource.
+func NewMessageResource() resource.Resource {
+	return &MessageResource{}
+}
+
+// MessageResource implements the Slack message resource.
+type MessageResource struct {
+	client *Client
+}
+
+// MessageResourceModel describes the message resource data model.
+type MessageResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	ChannelID   types.String `tfsdk:"channel_id"`
+	Text        types.String `tfsdk:"text"`
+	Blocks      types.String `tfsdk:"blocks"`
+	ThreadTS    types.String `tfsdk:"thread_ts"`
+	UnfurlLinks types.Bool   `tfsdk:"unfurl_links"`
+	Username    types.String `tfsdk:"username"`
+	IconEmoji   types.String `tfsdk:"icon_emoji"`
+	IconURL     types.String `tfsdk:"icon_url"`
+	TS          types.String `tfsdk:"ts"`
+}
+
+// Metadata returns the resource type name.
+func (r *MessageResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_message"
+}
+
+// Schema defines the schema for the resource.
+func (r *MessageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a message posted in a Slack conversation",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The resource ID, in the form `<channel_id>/<ts>`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the conversation to post the message in",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"text": schema.StringAttribute{
+				MarkdownDescription: "The text of the message. When `blocks` is set, it is used as the notification fallback.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("blocks")),
+				},
+			},
+			"blocks": schema.StringAttribute{
+				MarkdownDescription: "The Block Kit blocks of the message, as a JSON encoded array",
+				Optional:            true,
+				Validators: []validator.String{
+					blocksValidator{},
+				},
+			},
+			"thread_ts": schema.StringAttribute{
+				MarkdownDescription: "The timestamp of the message to reply to, to post the message in a thread",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"unfurl_links": schema.BoolAttribute{
+				MarkdownDescription: "Whether to unfurl the links of the message",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The name displayed as the author of the message. Requires the `chat:write.customize` scope.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"icon_emoji": schema.StringAttribute{
+				MarkdownDescription: "The emoji displayed as the author icon of the message. Requires the `chat:write.customize` scope.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("icon_url")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"icon_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the image displayed as the author icon of the message. Requires the `chat:write.customize` scope.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ts": schema.StringAttribute{
+				MarkdownDescription: "The timestamp identifying the message in the conversation",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *MessageResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *slack.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create posts the message.
+func (r *MessageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MessageResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options, err := messageContentOptions(data.Text, data.Blocks, false)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Blocks", err.Error())
+		return
+	}
+
+	if !data.ThreadTS.IsNull() {
+		options = append(options, slack.MsgOptionTS(data.ThreadTS.ValueString()))
+	}
+	if !data.UnfurlLinks.IsNull() {
+		if data.UnfurlLinks.ValueBool() {
+			options = append(options, slack.MsgOptionEnableLinkUnfurl())
+		} else {
+			options = append(options, slack.MsgOptionDisableLinkUnfurl())
+		}
+	}
+	if !data.Username.IsNull() {
+		options = append(options, slack.MsgOptionUsername(data.Username.ValueString()))
+	}
+	if !data.IconEmoji.IsNull() {
+		options = append(options, slack.MsgOptionIconEmoji(data.IconEmoji.ValueString()))
+	}
+	if !data.IconURL.IsNull() {
+		options = append(options, slack.MsgOptionIconURL(data.IconURL.ValueString()))
+	}
+
+	channelID := data.ChannelID.ValueString()
+	_, ts, err := r.client.PostMessageContext(ctx, channelID, options...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to post message: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(channelID + "/" + ts)
+	data.TS = types.StringValue(ts)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read detects messages deleted or edited from the Slack UI.
+func (r *MessageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MessageResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	message, err := findMessage(ctx, r.client, data.ChannelID.ValueString(), data.TS.ValueString(), data.ThreadTS.ValueString())
+	if err != nil {
+		if err.Error() == errChannelNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read message: %s", err))
+		return
+	}
+
+	if message == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Slack escapes &, < and > in the text it returns
+	if !data.Text.IsNull() {
+		data.Text = types.StringValue(unescapeMessageText(message.Text))
+	}
+
+	// Slack rewrites the blocks it stores (adding block IDs, defaults...), so
+	// the blocks only drifted when the stored ones miss some of the state.
+	if !data.Blocks.IsNull() {
+		blocks, err := json.Marshal(message.Blocks.BlockSet)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to encode message blocks: %s", err))
+			return
+		}
+
+		var got, want interface{}
+		if err := json.Unmarshal(blocks, &got); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to decode message blocks: %s", err))
+			return
+		}
+		if err := json.Unmarshal([]byte(data.Blocks.ValueString()), &want); err != nil || !jsonContains(got, want) {
+			data.Blocks = types.StringValue(string(blocks))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update edits the message in place.
+func (r *MessageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state MessageResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options, err := messageContentOptions(data.Text, data.Blocks, true)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Blocks", err.Error())
+		return
+	}
+
+	if _, _, _, err := r.client.UpdateMessageContext(ctx, state.ChannelID.ValueString(), state.TS.ValueString(), options...); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update message: %s", err))
+		return
+	}
+
+	data.ID = state.ID
+	data.TS = state.TS
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the message.
+func (r *MessageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MessageResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, _, err := r.client.DeleteMessageContext(ctx, data.ChannelID.ValueString(), data.TS.ValueString()); err != nil {
+		if err.Error() != errMessageNotFound && err.Error() != errChannelNotFound {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete message: %s", err))
+			return
+		}
+	}
+}
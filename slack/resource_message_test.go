@@ -0,0 +1,173 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/slack-go/slack"
+)
+
+func TestAccSlackMessageTest(t *testing.T) {
+	if os.Getenv("TF_ACC") != "1" {
+		t.Skip("Acceptance tests skipped unless env 'TF_ACC' is set to 1")
+		return
+	}
+	t.Parallel()
+
+	resourceName := "slack_message.test"
+
+	t.Run("update text and blocks", func(t *testing.T) {
+		channel := createTestConversation(t)
+		blocks := `[{"type":"section","text":{"type":"mrkdwn","text":"*Welcome aboard*"}}]`
+		var ts string
+
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			CheckDestroy:             testAccCheckMessageDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccSlackMessageConfig(channel.ID, "Welcome aboard"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "channel_id", channel.ID),
+						resource.TestCheckResourceAttr(resourceName, "text", "Welcome aboard"),
+						resource.TestCheckResourceAttrSet(resourceName, "ts"),
+					),
+				},
+				{
+					Config: testAccSlackMessageConfigWithBlocks(channel.ID, "Welcome aboard & enjoy", blocks),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "text", "Welcome aboard & enjoy"),
+						resource.TestCheckResourceAttr(resourceName, "blocks", blocks),
+						func(s *terraform.State) error {
+							ts = s.RootModule().Resources[resourceName].Primary.Attributes["ts"]
+							return nil
+						},
+					),
+				},
+				{
+					// Edit the blocks from outside Terraform, which must be
+					// reported as drift
+					PreConfig: func() {
+						_, _, _, err := getTestSlackClient().UpdateMessage(channel.ID, ts,
+							slack.MsgOptionText("Welcome aboard & enjoy", false),
+							slack.MsgOptionBlocks(slack.NewSectionBlock(slack.NewTextBlockObject(slack.MarkdownType, "*Edited*", false, false), nil, nil)),
+						)
+						if err != nil {
+							t.Fatalf("error editing message: %s", err)
+						}
+					},
+					Config:             testAccSlackMessageConfigWithBlocks(channel.ID, "Welcome aboard & enjoy", blocks),
+					PlanOnly:           true,
+					ExpectNonEmptyPlan: true,
+				},
+			},
+		})
+	})
+
+	t.Run("reply in thread", func(t *testing.T) {
+		channel := createTestConversation(t)
+
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			CheckDestroy:             testAccCheckMessageDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccSlackMessageConfigWithReply(channel.ID),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttrPair("slack_message.reply", "thread_ts", resourceName, "ts"),
+						resource.TestCheckResourceAttr("slack_message.reply", "unfurl_links", "false"),
+						resource.TestCheckResourceAttrSet("slack_message.reply", "ts"),
+					),
+				},
+				{
+					// The reply must still be found in the thread after a refresh
+					Config:   testAccSlackMessageConfigWithReply(channel.ID),
+					PlanOnly: true,
+				},
+			},
+		})
+	})
+
+	t.Run("invalid blocks", func(t *testing.T) {
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config:      testAccSlackMessageConfigWithBlocks("C00000000", "Invalid", `[{"type":"header"}]`),
+					ExpectError: regexp.MustCompile("header block 0 is missing text"),
+				},
+				{
+					Config:      testAccSlackMessageConfigWithBlocks("C00000000", "Invalid", `[{"type":"carousel"}]`),
+					ExpectError: regexp.MustCompile(`unsupported type "carousel"`),
+				},
+			},
+		})
+	})
+}
+
+func testAccCheckMessageDestroy(s *terraform.State) error {
+	c := getTestSlackClient()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "slack_message" {
+			continue
+		}
+
+		channelID := rs.Primary.Attributes["channel_id"]
+		message, err := findMessage(context.Background(), &Client{Client: c}, channelID, rs.Primary.Attributes["ts"], rs.Primary.Attributes["thread_ts"])
+		if err != nil {
+			if err.Error() == errChannelNotFound {
+				continue
+			}
+			return fmt.Errorf("error reading message %s: %s", rs.Primary.ID, err)
+		}
+
+		if message != nil {
+			return fmt.Errorf("message %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccSlackMessageConfig(channelID, text string) string {
+	return fmt.Sprintf(`
+resource slack_message test {
+  channel_id = "%s"
+  text       = "%s"
+}
+`, channelID, text)
+}
+
+func testAccSlackMessageConfigWithBlocks(channelID, text, blocks string) string {
+	return fmt.Sprintf(`
+resource slack_message test {
+  channel_id = "%s"
+  text       = "%s"
+  blocks     = %q
+}
+`, channelID, text, blocks)
+}
+
+func testAccSlackMessageConfigWithReply(channelID string) string {
+	return fmt.Sprintf(`
+resource slack_message test {
+  channel_id = "%s"
+  text       = "Release notes"
+}
+
+resource slack_message reply {
+  channel_id   = slack_message.test.channel_id
+  thread_ts    = slack_message.test.ts
+  text         = "Details at https://example.com/releases"
+  unfurl_links = false
+}
+`, channelID)
+}
@@ -0,0 +1,214 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/slack-go/slack"
+)
+
+var _ resource.Resource = &ReminderResource{}
+
+// NewReminderResource creates a new Slack reminder resource.
+func NewReminderResource() resource.Resource {
+	return &ReminderResource{}
+}
+
+// ReminderResource implements the Slack reminder resource.
+type ReminderResource struct {
+	client *Client
+}
+
+// ReminderResourceModel describes the reminder resource data model.
+type ReminderResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Text       types.String `tfsdk:"text"`
+	Time       types.String `tfsdk:"time"`
+	UserID     types.String `tfsdk:"user_id"`
+	Recurring  types.Bool   `tfsdk:"recurring"`
+	CompleteTS types.Int64  `tfsdk:"complete_ts"`
+}
+
+// reminderResponse is the reminders.add and reminders.info answer. slack-go
+// always sends the user to reminders.add and does not implement
+// reminders.info.
+type reminderResponse struct {
+	Reminder slack.Reminder `json:"reminder"`
+	slack.SlackResponse
+}
+
+// Metadata returns the resource type name.
+func (r *ReminderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reminder"
+}
+
+// Schema defines the schema for the resource.
+func (r *ReminderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Slack reminder, once or recurring",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The reminder ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"text": schema.StringAttribute{
+				MarkdownDescription: "The content of the reminder",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"time": schema.StringAttribute{
+				MarkdownDescription: "When the reminder triggers: a Unix timestamp, a number of seconds from now, or a natural language description such as `every weekday at 9am`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user to remind. Defaults to the user owning the token.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"recurring": schema.BoolAttribute{
+				MarkdownDescription: "Whether the reminder is recurring",
+				Computed:            true,
+			},
+			"complete_ts": schema.Int64Attribute{
+				MarkdownDescription: "When a reminder which is not recurring was marked as complete, as a Unix timestamp. 0 while it is not complete.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ReminderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *slack.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create adds the reminder.
+func (r *ReminderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ReminderResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	values := url.Values{
+		"text": {data.Text.ValueString()},
+		"time": {data.Time.ValueString()},
+	}
+	if !data.UserID.IsUnknown() && !data.UserID.IsNull() {
+		values.Set("user", data.UserID.ValueString())
+	}
+
+	var added reminderResponse
+	if err := r.client.callMethod(ctx, "reminders.add", values, &added); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add reminder: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(added.Reminder.ID)
+	updateReminderModel(&data, &added.Reminder)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read reads the reminder.
+func (r *ReminderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ReminderResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var info reminderResponse
+	if err := r.client.callMethod(ctx, "reminders.info", url.Values{"reminder": {data.ID.ValueString()}}, &info); err != nil {
+		if err.Error() == errNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read reminder %s: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	// The time cannot be read back as configured, so it is kept. Completed
+	// reminders are kept as well so they are not added again.
+	data.Text = types.StringValue(info.Reminder.Text)
+	updateReminderModel(&data, &info.Reminder)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never called as every argument requires a replacement.
+func (r *ReminderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ReminderResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the reminder.
+func (r *ReminderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ReminderResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteReminderContext(ctx, data.ID.ValueString())
+	if err != nil && err.Error() != errNotFound {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete reminder %s: %s", data.ID.ValueString(), err))
+		return
+	}
+}
+
+// updateReminderModel sets the attributes of data computed by Slack.
+func updateReminderModel(data *ReminderResourceModel, reminder *slack.Reminder) {
+	data.UserID = types.StringValue(reminder.User)
+	data.Recurring = types.BoolValue(reminder.Recurring)
+	data.CompleteTS = types.Int64Value(int64(reminder.CompleteTS))
+}
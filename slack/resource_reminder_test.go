@@ -0,0 +1,93 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/slack-go/slack"
+)
+
+const (
+	reminderTextPrefix = "test-acc-slack-reminder-test"
+)
+
+func init() {
+	resource.AddTestSweepers("slack_reminder", &resource.Sweeper{
+		Name: "slack_reminder",
+		F: func(string) error {
+			client, err := sharedSlackClient()
+			if err != nil {
+				return fmt.Errorf("error getting client: %s", err)
+			}
+			c := client.(*slack.Client)
+			reminders, err := c.ListRemindersContext(context.Background())
+			if err != nil {
+				return fmt.Errorf("[ERROR] error getting reminders: %s", err)
+			}
+			var sweeperErrs *multierror.Error
+			for _, reminder := range reminders {
+				if strings.HasPrefix(reminder.Text, reminderTextPrefix) {
+					if err := c.DeleteReminderContext(context.Background(), reminder.ID); err != nil {
+						sweeperErr := fmt.Errorf("deleting reminder %s during sweep: %s", reminder.ID, err)
+						log.Printf("[ERROR] %s", sweeperErr)
+						sweeperErrs = multierror.Append(sweeperErrs, err)
+					} else {
+						fmt.Printf("[INFO] deleted reminder %s during sweep\n", reminder.ID)
+					}
+				}
+			}
+			return sweeperErrs.ErrorOrNil()
+		},
+	})
+}
+
+func TestAccSlackReminderTest(t *testing.T) {
+	if os.Getenv("TF_ACC") != "1" {
+		t.Skip("Acceptance tests skipped unless env 'TF_ACC' is set to 1")
+		return
+	}
+
+	resourceName := "slack_reminder.test"
+	text := acctest.RandomWithPrefix(reminderTextPrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackReminderConfig(text, "every monday at 9am"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "text", text),
+					// The reminders methods only accept user tokens, so the
+					// reminder is for the user owning the token
+					resource.TestCheckResourceAttrSet(resourceName, "user_id"),
+					resource.TestCheckResourceAttr(resourceName, "recurring", "true"),
+				),
+			},
+			{
+				Config: testAccSlackReminderConfig(text, "in 2 days"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "recurring", "false"),
+					resource.TestCheckResourceAttr(resourceName, "complete_ts", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSlackReminderConfig(text, time string) string {
+	return fmt.Sprintf(`
+resource slack_reminder test {
+  text = "%s"
+  time = "%s"
+}
+`, text, time)
+}
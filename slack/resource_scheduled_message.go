@@ -0,0 +1,380 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/slack-go/slack"
+)
+
+const (
+	errInvalidScheduledMessageID = "invalid_scheduled_message_id"
+
+	// maxScheduleAhead is how far in the future Slack accepts to schedule a message.
+	maxScheduleAhead = 120 * 24 * time.Hour
+)
+
+var _ resource.Resource = &ScheduledMessageResource{}
+var _ resource.ResourceWithModifyPlan = &ScheduledMessageResource{}
+
+// NewScheduledMessageResource creates a new Slack scheduled message resource.
+func NewScheduledMessageResource() resource.Resource {
+	return &ScheduledMessageResource{}
+}
+
+// ScheduledMessageResource implements the Slack scheduled message resource.
+type ScheduledMessageResource struct {
+	client *Client
+}
+
+// ScheduledMessageResourceModel describes the scheduled message resource data model.
+type ScheduledMessageResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	ChannelID          types.String `tfsdk:"channel_id"`
+	PostAt             types.String `tfsdk:"post_at"`
+	Text               types.String `tfsdk:"text"`
+	Blocks             types.String `tfsdk:"blocks"`
+	ThreadTS           types.String `tfsdk:"thread_ts"`
+	UnfurlLinks        types.Bool   `tfsdk:"unfurl_links"`
+	ScheduledMessageID types.String `tfsdk:"scheduled_message_id"`
+	Posted             types.Bool   `tfsdk:"posted"`
+}
+
+// Metadata returns the resource type name.
+func (r *ScheduledMessageResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scheduled_message"
+}
+
+// Schema defines the schema for the resource.
+func (r *ScheduledMessageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a message scheduled to be posted in a Slack conversation",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The resource ID, in the form `<channel_id>/<scheduled_message_id>`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the conversation to post the message in",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"post_at": schema.StringAttribute{
+				MarkdownDescription: "When the message is posted, as an RFC 3339 timestamp. Must be in the future and at most 120 days ahead when the message is scheduled.",
+				Required:            true,
+				Validators: []validator.String{
+					postAtValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"text": schema.StringAttribute{
+				MarkdownDescription: "The text of the message. When `blocks` is set, it is used as the notification fallback.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("blocks")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"blocks": schema.StringAttribute{
+				MarkdownDescription: "The Block Kit blocks of the message, as a JSON encoded array",
+				Optional:            true,
+				Validators: []validator.String{
+					blocksValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"thread_ts": schema.StringAttribute{
+				MarkdownDescription: "The timestamp of the message to reply to, to post the message in a thread",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"unfurl_links": schema.BoolAttribute{
+				MarkdownDescription: "Whether to unfurl the links of the message",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"scheduled_message_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the scheduled message",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"posted": schema.BoolAttribute{
+				MarkdownDescription: "Whether Slack has posted the message",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ScheduledMessageResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *slack.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan checks that the message can be scheduled at post_at. It only
+// runs when a new message is scheduled, so that the configuration of a
+// message which was already posted stays valid.
+func (r *ScheduledMessageResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to schedule when destroying
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ScheduledMessageResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.PostAt.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state ScheduledMessageResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() || !scheduledMessageChanged(plan, state) {
+			return
+		}
+	}
+
+	// The format is checked by postAtValidator
+	postAt, err := time.Parse(time.RFC3339, plan.PostAt.ValueString())
+	if err != nil {
+		return
+	}
+
+	now := time.Now()
+	switch {
+	case !postAt.After(now):
+		resp.Diagnostics.AddAttributeError(path.Root("post_at"), "Invalid Post Time",
+			fmt.Sprintf("post_at must be in the future, got %s. If the message was already posted, remove it from the configuration.", plan.PostAt.ValueString()))
+	case postAt.After(now.Add(maxScheduleAhead)):
+		resp.Diagnostics.AddAttributeError(path.Root("post_at"), "Invalid Post Time",
+			fmt.Sprintf("Slack only schedules messages up to 120 days ahead, got %s", plan.PostAt.ValueString()))
+	}
+}
+
+// Create schedules the message.
+func (r *ScheduledMessageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ScheduledMessageResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	postAt, err := time.Parse(time.RFC3339, data.PostAt.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Post Time", err.Error())
+		return
+	}
+
+	options, err := messageContentOptions(data.Text, data.Blocks, false)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Blocks", err.Error())
+		return
+	}
+
+	if !data.ThreadTS.IsNull() {
+		options = append(options, slack.MsgOptionTS(data.ThreadTS.ValueString()))
+	}
+	if !data.UnfurlLinks.IsNull() {
+		if data.UnfurlLinks.ValueBool() {
+			options = append(options, slack.MsgOptionEnableLinkUnfurl())
+		} else {
+			options = append(options, slack.MsgOptionDisableLinkUnfurl())
+		}
+	}
+
+	channelID := data.ChannelID.ValueString()
+	_, scheduledMessageID, err := r.client.ScheduleMessageContext(ctx, channelID, strconv.FormatInt(postAt.Unix(), 10), options...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to schedule message: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(channelID + "/" + scheduledMessageID)
+	data.ScheduledMessageID = types.StringValue(scheduledMessageID)
+	data.Posted = types.BoolValue(false)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read checks that the message is still scheduled, or was posted.
+func (r *ScheduledMessageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ScheduledMessageResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A posted message is kept in the state, so that its configuration does
+	// not schedule it again
+	if data.Posted.ValueBool() {
+		return
+	}
+
+	scheduled, err := findScheduledMessage(ctx, r.client, data.ChannelID.ValueString(), data.ScheduledMessageID.ValueString())
+	if err != nil {
+		if err.Error() == errChannelNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list scheduled messages: %s", err))
+		return
+	}
+
+	// Slack drops the message from the list once it has been delivered, or
+	// when it was deleted from the Slack UI
+	if scheduled == nil {
+		postAt, err := time.Parse(time.RFC3339, data.PostAt.ValueString())
+		if err != nil || postAt.After(time.Now()) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		data.Posted = types.BoolValue(true)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never called as every attribute requires a replacement, Slack
+// does not support editing a scheduled message.
+func (r *ScheduledMessageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ScheduledMessageResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete cancels the scheduled message. A message which was already posted
+// is left in the conversation.
+func (r *ScheduledMessageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ScheduledMessageResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Posted.ValueBool() {
+		return
+	}
+
+	_, err := r.client.DeleteScheduledMessageContext(ctx, &slack.DeleteScheduledMessageParameters{
+		Channel:            data.ChannelID.ValueString(),
+		ScheduledMessageID: data.ScheduledMessageID.ValueString(),
+	})
+	if err != nil && err.Error() != errInvalidScheduledMessageID && err.Error() != errChannelNotFound {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete scheduled message: %s", err))
+		return
+	}
+}
+
+// scheduledMessageChanged returns whether plan changes an argument of state,
+// all of which schedule a new message.
+func scheduledMessageChanged(plan, state ScheduledMessageResourceModel) bool {
+	return !plan.ChannelID.Equal(state.ChannelID) ||
+		!plan.PostAt.Equal(state.PostAt) ||
+		!plan.Text.Equal(state.Text) ||
+		!plan.Blocks.Equal(state.Blocks) ||
+		!plan.ThreadTS.Equal(state.ThreadTS) ||
+		!plan.UnfurlLinks.Equal(state.UnfurlLinks)
+}
+
+// findScheduledMessage returns the pending scheduled message of the
+// conversation with the given ID, or nil when it is not pending anymore.
+func findScheduledMessage(ctx context.Context, client *Client, channelID, scheduledMessageID string) (*slack.ScheduledMessage, error) {
+	cursor := ""
+	for {
+		messages, nextCursor, err := client.GetScheduledMessagesContext(ctx, &slack.GetScheduledMessagesParameters{
+			Channel: channelID,
+			Cursor:  cursor,
+			Limit:   100,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, message := range messages {
+			if message.ID == scheduledMessageID {
+				return &message, nil
+			}
+		}
+
+		cursor = nextCursor
+		if cursor == "" {
+			return nil, nil
+		}
+	}
+}
+
+var _ validator.String = postAtValidator{}
+
+// postAtValidator checks that a scheduled message time is an RFC 3339
+// timestamp. Whether Slack accepts it is checked when planning.
+type postAtValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v postAtValidator) Description(_ context.Context) string {
+	return "value must be an RFC 3339 timestamp"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v postAtValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v postAtValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Post Time", fmt.Sprintf("post_at must be an RFC 3339 timestamp: %s", err))
+	}
+}
@@ -0,0 +1,135 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccSlackScheduledMessageTest(t *testing.T) {
+	if os.Getenv("TF_ACC") != "1" {
+		t.Skip("Acceptance tests skipped unless env 'TF_ACC' is set to 1")
+		return
+	}
+	t.Parallel()
+
+	resourceName := "slack_scheduled_message.test"
+
+	t.Run("reschedule", func(t *testing.T) {
+		channel := createTestConversation(t)
+		postAt := time.Now().Add(48 * time.Hour).UTC().Format(time.RFC3339)
+		newPostAt := time.Now().Add(72 * time.Hour).UTC().Format(time.RFC3339)
+
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			CheckDestroy:             testAccCheckScheduledMessageDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccSlackScheduledMessageConfig(channel.ID, postAt, "Quarterly security reminder"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "channel_id", channel.ID),
+						resource.TestCheckResourceAttr(resourceName, "post_at", postAt),
+						resource.TestCheckResourceAttrSet(resourceName, "scheduled_message_id"),
+					),
+				},
+				{
+					Config: testAccSlackScheduledMessageConfig(channel.ID, newPostAt, "Quarterly security reminder"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "post_at", newPostAt),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("posted", func(t *testing.T) {
+		channel := createTestConversation(t)
+		postAt := time.Now().Add(2 * time.Minute).UTC().Truncate(time.Second)
+
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			CheckDestroy:             testAccCheckScheduledMessageDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccSlackScheduledMessageConfig(channel.ID, postAt.Format(time.RFC3339), "Deploy window opens now"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "posted", "false"),
+					),
+				},
+				{
+					// Once posted, the message stays in the state and its
+					// past post_at plans no change
+					PreConfig: func() {
+						time.Sleep(time.Until(postAt.Add(time.Minute)))
+					},
+					Config:   testAccSlackScheduledMessageConfig(channel.ID, postAt.Format(time.RFC3339), "Deploy window opens now"),
+					PlanOnly: true,
+				},
+				{
+					Config: testAccSlackScheduledMessageConfig(channel.ID, postAt.Format(time.RFC3339), "Deploy window opens now"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "posted", "true"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("invalid post_at", func(t *testing.T) {
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config:      testAccSlackScheduledMessageConfig("C00000000", "2020-01-01T00:00:00Z", "Too late"),
+					ExpectError: regexp.MustCompile("post_at must be in the future"),
+				},
+				{
+					Config:      testAccSlackScheduledMessageConfig("C00000000", time.Now().Add(200*24*time.Hour).UTC().Format(time.RFC3339), "Too early"),
+					ExpectError: regexp.MustCompile("up to 120 days ahead"),
+				},
+			},
+		})
+	})
+}
+
+func testAccCheckScheduledMessageDestroy(s *terraform.State) error {
+	c := getTestSlackClient()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "slack_scheduled_message" {
+			continue
+		}
+
+		message, err := findScheduledMessage(context.Background(), &Client{Client: c}, rs.Primary.Attributes["channel_id"], rs.Primary.Attributes["scheduled_message_id"])
+		if err != nil {
+			if err.Error() == errChannelNotFound {
+				continue
+			}
+			return fmt.Errorf("error listing scheduled messages: %s", err)
+		}
+
+		if message != nil {
+			return fmt.Errorf("message %s is still scheduled", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccSlackScheduledMessageConfig(channelID, postAt, text string) string {
+	return fmt.Sprintf(`
+resource slack_scheduled_message test {
+  channel_id = "%s"
+  post_at    = "%s"
+  text       = "%s"
+}
+`, channelID, postAt, text)
+}
@@ -0,0 +1,205 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/slack-go/slack"
+)
+
+var _ resource.Resource = &TeamDefaultChannelsResource{}
+var _ resource.ResourceWithImportState = &TeamDefaultChannelsResource{}
+
+// NewTeamDefaultChannelsResource creates a new Slack team default channels resource.
+func NewTeamDefaultChannelsResource() resource.Resource {
+	return &TeamDefaultChannelsResource{}
+}
+
+// TeamDefaultChannelsResource implements the Slack team default channels resource.
+type TeamDefaultChannelsResource struct {
+	client *Client
+}
+
+// TeamDefaultChannelsResourceModel describes the team default channels resource data model.
+type TeamDefaultChannelsResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	TeamID     types.String `tfsdk:"team_id"`
+	ChannelIDs types.Set    `tfsdk:"channel_ids"`
+}
+
+// teamSettingsResponse is the admin.teams.settings.info answer.
+type teamSettingsResponse struct {
+	Team struct {
+		ID              string   `json:"id"`
+		Name            string   `json:"name"`
+		Description     *string  `json:"description"`
+		Discoverability *string  `json:"discoverability"`
+		DefaultChannels []string `json:"default_channels"`
+	} `json:"team"`
+	slack.SlackResponse
+}
+
+// Metadata returns the resource type name.
+func (r *TeamDefaultChannelsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_default_channels"
+}
+
+// Schema defines the schema for the resource.
+func (r *TeamDefaultChannelsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the channels new members of a Slack workspace join automatically",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The workspace ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workspace",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"channel_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the default channels of the workspace",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *TeamDefaultChannelsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *slack.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create sets the default channels of the workspace.
+func (r *TeamDefaultChannelsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TeamDefaultChannelsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setDefaultChannels(ctx, data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set default channels: %s", err))
+		return
+	}
+
+	data.ID = data.TeamID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read reads the default channels of the workspace.
+func (r *TeamDefaultChannelsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TeamDefaultChannelsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := getTeamSettings(ctx, r.client, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workspace settings: %s", err))
+		return
+	}
+
+	channelIDs, diags := types.SetValueFrom(ctx, types.StringType, nonNilStrings(settings.Team.DefaultChannels))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TeamID = data.ID
+	data.ChannelIDs = channelIDs
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update sets the default channels of the workspace.
+func (r *TeamDefaultChannelsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state TeamDefaultChannelsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setDefaultChannels(ctx, data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set default channels: %s", err))
+		return
+	}
+
+	data.ID = state.ID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete leaves the default channels as they are, a workspace always has at
+// least one.
+func (r *TeamDefaultChannelsResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// ImportState imports the default channels of a Slack workspace using its ID.
+func (r *TeamDefaultChannelsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setDefaultChannels replaces the default channels of the workspace.
+func (r *TeamDefaultChannelsResource) setDefaultChannels(ctx context.Context, data TeamDefaultChannelsResourceModel) error {
+	var channelIDs []string
+	if diags := data.ChannelIDs.ElementsAs(ctx, &channelIDs, false); diags.HasError() {
+		return fmt.Errorf("unable to read channel_ids")
+	}
+
+	values := url.Values{
+		"team_id":     {data.TeamID.ValueString()},
+		"channel_ids": {strings.Join(channelIDs, ",")},
+	}
+	return r.client.callMethod(ctx, "admin.teams.settings.setDefaultChannels", values, &slack.SlackResponse{})
+}
+
+// getTeamSettings returns the settings of the workspace.
+func getTeamSettings(ctx context.Context, client *Client, teamID string) (*teamSettingsResponse, error) {
+	var settings teamSettingsResponse
+	if err := client.callMethod(ctx, "admin.teams.settings.info", url.Values{"team_id": {teamID}}, &settings); err != nil {
+		return nil, err
+	}
+
+	return &settings, nil
+}
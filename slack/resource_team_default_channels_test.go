@@ -0,0 +1,59 @@
+package slack
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSlackTeamDefaultChannelsTest(t *testing.T) {
+	if os.Getenv("TF_ACC") != "1" {
+		t.Skip("Acceptance tests skipped unless env 'TF_ACC' is set to 1")
+		return
+	}
+	testAccPreCheckAdmin(t)
+
+	resourceName := "slack_team_default_channels.test"
+	channel00 := createTestConversation(t)
+	channel01 := createTestConversation(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackTeamDefaultChannelsConfig(fmt.Sprintf(`["%s"]`, channel00.ID)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "team_id", "data.slack_team.current", "id"),
+					resource.TestCheckResourceAttr(resourceName, "channel_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "channel_ids.*", channel00.ID),
+				),
+			},
+			{
+				Config: testAccSlackTeamDefaultChannelsConfig(fmt.Sprintf(`["%s", "%s"]`, channel00.ID, channel01.ID)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "channel_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "channel_ids.*", channel01.ID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSlackTeamDefaultChannelsConfig(channelIDs string) string {
+	return fmt.Sprintf(`
+data slack_team current {}
+
+resource slack_team_default_channels test {
+  team_id     = data.slack_team.current.id
+  channel_ids = %s
+}
+`, channelIDs)
+}
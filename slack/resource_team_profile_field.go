@@ -0,0 +1,342 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/slack-go/slack"
+)
+
+const (
+	profileFieldTypeOptionsList = "options_list"
+	profileFieldVisible         = "visible"
+	profileFieldHidden          = "hidden"
+)
+
+var _ resource.Resource = &TeamProfileFieldResource{}
+var _ resource.ResourceWithImportState = &TeamProfileFieldResource{}
+var _ resource.ResourceWithValidateConfig = &TeamProfileFieldResource{}
+
+// NewTeamProfileFieldResource creates a new Slack team profile field resource.
+func NewTeamProfileFieldResource() resource.Resource {
+	return &TeamProfileFieldResource{}
+}
+
+// TeamProfileFieldResource implements the Slack team profile field resource.
+type TeamProfileFieldResource struct {
+	client *Client
+}
+
+// TeamProfileFieldResourceModel describes the team profile field resource data model.
+type TeamProfileFieldResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Label      types.String `tfsdk:"label"`
+	Hint       types.String `tfsdk:"hint"`
+	Type       types.String `tfsdk:"type"`
+	Options    types.List   `tfsdk:"options"`
+	Ordering   types.Int64  `tfsdk:"ordering"`
+	Visibility types.String `tfsdk:"visibility"`
+}
+
+// teamProfileFieldPayload is a field definition sent to team.profile.set.
+type teamProfileFieldPayload struct {
+	ID             string   `json:"id,omitempty"`
+	Label          string   `json:"label"`
+	Hint           string   `json:"hint"`
+	Type           string   `json:"type"`
+	PossibleValues []string `json:"possible_values,omitempty"`
+	Ordering       *int64   `json:"ordering,omitempty"`
+	IsHidden       bool     `json:"is_hidden"`
+}
+
+// Metadata returns the resource type name.
+func (r *TeamProfileFieldResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_profile_field"
+}
+
+// Schema defines the schema for the resource.
+func (r *TeamProfileFieldResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a custom field of the profiles of the Slack workspace",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The field ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"label": schema.StringAttribute{
+				MarkdownDescription: "The label of the field",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"hint": schema.StringAttribute{
+				MarkdownDescription: "The hint displayed below the field. Default is empty.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the field, one of `text`, `date`, `link`, `options_list` or `user`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("text", "date", "link", profileFieldTypeOptionsList, "user"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"options": schema.ListAttribute{
+				MarkdownDescription: "The values allowed for an `options_list` field",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"ordering": schema.Int64Attribute{
+				MarkdownDescription: "The position of the field in the profile. Defaults to the last position.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"visibility": schema.StringAttribute{
+				MarkdownDescription: "Whether the field is shown on profiles, either `visible` or `hidden`. Default is `visible`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(profileFieldVisible),
+				Validators: []validator.String{
+					stringvalidator.OneOf(profileFieldVisible, profileFieldHidden),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that options are only set on options_list fields.
+func (r *TeamProfileFieldResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data TeamProfileFieldResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Type.IsUnknown() || data.Options.IsUnknown() {
+		return
+	}
+
+	isOptionsList := data.Type.ValueString() == profileFieldTypeOptionsList
+	switch {
+	case isOptionsList && data.Options.IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("options"), "Missing Options", "options must be set for an options_list field")
+	case !isOptionsList && !data.Options.IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("options"), "Unexpected Options", "options can only be set for an options_list field")
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *TeamProfileFieldResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *slack.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create adds the field to the team profile.
+func (r *TeamProfileFieldResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TeamProfileFieldResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	before, err := getTeamProfile(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team profile: %s", err))
+		return
+	}
+	existing := make(map[string]bool, len(before.Profile.Fields))
+	for _, field := range before.Profile.Fields {
+		existing[field.ID] = true
+	}
+
+	if err := r.setField(ctx, "", data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add team profile field: %s", err))
+		return
+	}
+
+	// team.profile.set does not return the ID of the new field, it is the
+	// only field with the label which did not exist before
+	after, err := getTeamProfile(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team profile: %s", err))
+		return
+	}
+
+	var created *teamProfileField
+	for i, field := range after.Profile.Fields {
+		if !existing[field.ID] && field.Label == data.Label.ValueString() {
+			created = &after.Profile.Fields[i]
+			break
+		}
+	}
+	if created == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find the team profile field %q after adding it", data.Label.ValueString()))
+		return
+	}
+
+	data.ID = types.StringValue(created.ID)
+	data.Ordering = types.Int64Value(int64(created.Ordering))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read reads the field definition from the team profile.
+func (r *TeamProfileFieldResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TeamProfileFieldResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err := getTeamProfile(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team profile: %s", err))
+		return
+	}
+
+	var found *teamProfileField
+	for i, field := range profile.Profile.Fields {
+		if field.ID == data.ID.ValueString() {
+			found = &profile.Profile.Fields[i]
+			break
+		}
+	}
+
+	if found == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Label = types.StringValue(found.Label)
+	data.Hint = types.StringValue(found.Hint)
+	data.Type = types.StringValue(found.Type)
+	data.Ordering = types.Int64Value(int64(found.Ordering))
+	data.Visibility = types.StringValue(profileFieldVisible)
+	if found.IsHidden {
+		data.Visibility = types.StringValue(profileFieldHidden)
+	}
+
+	if len(found.PossibleValues) > 0 {
+		options, diags := types.ListValueFrom(ctx, types.StringType, found.PossibleValues)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Options = options
+	} else {
+		data.Options = types.ListNull(types.StringType)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update edits the field definition.
+func (r *TeamProfileFieldResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state TeamProfileFieldResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setField(ctx, state.ID.ValueString(), data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update team profile field: %s", err))
+		return
+	}
+
+	data.ID = state.ID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the field from the team profile, along with the values
+// users entered in it.
+func (r *TeamProfileFieldResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TeamProfileFieldResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.callMethod(ctx, "team.profile.delete", url.Values{"id": {data.ID.ValueString()}}, &slack.SlackResponse{})
+	if err != nil && err.Error() != errNotFound {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete team profile field: %s", err))
+		return
+	}
+}
+
+// ImportState imports a Slack team profile field using its ID.
+func (r *TeamProfileFieldResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setField sends the field definition to team.profile.set. An empty id adds
+// a new field.
+func (r *TeamProfileFieldResource) setField(ctx context.Context, id string, data TeamProfileFieldResourceModel) error {
+	field := teamProfileFieldPayload{
+		ID:       id,
+		Label:    data.Label.ValueString(),
+		Hint:     data.Hint.ValueString(),
+		Type:     data.Type.ValueString(),
+		IsHidden: data.Visibility.ValueString() == profileFieldHidden,
+	}
+
+	if !data.Options.IsNull() {
+		if diags := data.Options.ElementsAs(ctx, &field.PossibleValues, false); diags.HasError() {
+			return fmt.Errorf("unable to read options")
+		}
+	}
+	if !data.Ordering.IsNull() && !data.Ordering.IsUnknown() {
+		ordering := data.Ordering.ValueInt64()
+		field.Ordering = &ordering
+	}
+
+	encoded, err := json.Marshal(map[string][]teamProfileFieldPayload{"fields": {field}})
+	if err != nil {
+		return err
+	}
+
+	return r.client.callMethod(ctx, "team.profile.set", url.Values{"profile": {string(encoded)}}, &slack.SlackResponse{})
+}
@@ -0,0 +1,110 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccSlackTeamProfileFieldTest(t *testing.T) {
+	if os.Getenv("TF_ACC") != "1" {
+		t.Skip("Acceptance tests skipped unless env 'TF_ACC' is set to 1")
+		return
+	}
+	t.Parallel()
+
+	resourceName := "slack_team_profile_field.test"
+
+	t.Run("update options field", func(t *testing.T) {
+		testAccPreCheckAdmin(t)
+		label := acctest.RandomWithPrefix("tf-test-field")
+
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			CheckDestroy:             testAccCheckTeamProfileFieldDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccSlackTeamProfileFieldConfig(label, `["Platform", "Product"]`, "visible"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttrSet(resourceName, "id"),
+						resource.TestCheckResourceAttr(resourceName, "label", label),
+						resource.TestCheckResourceAttr(resourceName, "type", "options_list"),
+						resource.TestCheckResourceAttr(resourceName, "options.#", "2"),
+						resource.TestCheckResourceAttrSet(resourceName, "ordering"),
+					),
+				},
+				{
+					Config: testAccSlackTeamProfileFieldConfig(label, `["Platform", "Product", "Sales"]`, "hidden"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "options.#", "3"),
+						resource.TestCheckResourceAttr(resourceName, "visibility", "hidden"),
+					),
+				},
+				{
+					ResourceName:      resourceName,
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	})
+
+	t.Run("options on text field", func(t *testing.T) {
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: `
+resource slack_team_profile_field test {
+  label   = "Department"
+  type    = "text"
+  options = ["Platform"]
+}
+`,
+					ExpectError: regexp.MustCompile("options can only be set for an options_list field"),
+				},
+			},
+		})
+	})
+}
+
+func testAccCheckTeamProfileFieldDestroy(s *terraform.State) error {
+	profile, err := getTeamProfile(context.Background(), NewClient(os.Getenv("SLACK_TOKEN")))
+	if err != nil {
+		return fmt.Errorf("error reading team profile: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "slack_team_profile_field" {
+			continue
+		}
+
+		for _, field := range profile.Profile.Fields {
+			if field.ID == rs.Primary.ID {
+				return fmt.Errorf("team profile field %s still exists", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccSlackTeamProfileFieldConfig(label, options, visibility string) string {
+	return fmt.Sprintf(`
+resource slack_team_profile_field test {
+  label      = "%s"
+  hint       = "The team you belong to"
+  type       = "options_list"
+  options    = %s
+  visibility = "%s"
+}
+`, label, options, visibility)
+}
@@ -0,0 +1,224 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/slack-go/slack"
+)
+
+var _ resource.Resource = &TeamSettingsResource{}
+var _ resource.ResourceWithImportState = &TeamSettingsResource{}
+
+// NewTeamSettingsResource creates a new Slack team settings resource.
+func NewTeamSettingsResource() resource.Resource {
+	return &TeamSettingsResource{}
+}
+
+// TeamSettingsResource implements the Slack team settings resource.
+type TeamSettingsResource struct {
+	client *Client
+}
+
+// TeamSettingsResourceModel describes the team settings resource data model.
+type TeamSettingsResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	TeamID          types.String `tfsdk:"team_id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	Discoverability types.String `tfsdk:"discoverability"`
+	IconURL         types.String `tfsdk:"icon_url"`
+}
+
+// Metadata returns the resource type name.
+func (r *TeamSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_settings"
+}
+
+// Schema defines the schema for the resource.
+func (r *TeamSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the name, description, icon and discoverability of a Slack workspace. Only the settings set in the configuration are changed.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The workspace ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workspace",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the workspace",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the workspace",
+				Optional:            true,
+			},
+			"discoverability": schema.StringAttribute{
+				MarkdownDescription: "Who can find and join the workspace, one of `open`, `invite_only`, `closed` or `unlisted`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("open", "invite_only", "closed", "unlisted"),
+				},
+			},
+			"icon_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the image used as icon of the workspace",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *TeamSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *slack.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create applies the configured settings.
+func (r *TeamSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TeamSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.applySettings(ctx, data, TeamSettingsResourceModel{}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set workspace settings: %s", err))
+		return
+	}
+
+	data.ID = data.TeamID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read reads back the managed settings.
+func (r *TeamSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TeamSettingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := getTeamSettings(ctx, r.client, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workspace settings: %s", err))
+		return
+	}
+
+	// An imported resource only knows its ID, so it adopts every setting
+	imported := data.TeamID.IsNull()
+
+	data.TeamID = data.ID
+	if imported || !data.Name.IsNull() {
+		data.Name = types.StringValue(settings.Team.Name)
+	}
+	if (imported || !data.Description.IsNull()) && settings.Team.Description != nil {
+		data.Description = types.StringValue(*settings.Team.Description)
+	}
+	if (imported || !data.Discoverability.IsNull()) && settings.Team.Discoverability != nil {
+		data.Discoverability = types.StringValue(*settings.Team.Discoverability)
+	}
+	// Slack serves the icon from its own URLs, so the configured URL is kept
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update applies the changed settings.
+func (r *TeamSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state TeamSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.applySettings(ctx, data, state); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set workspace settings: %s", err))
+		return
+	}
+
+	data.ID = state.ID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete leaves the settings as they are, it only stops managing them.
+func (r *TeamSettingsResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// ImportState imports the settings of a Slack workspace using its ID.
+func (r *TeamSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// applySettings calls the admin.teams.settings.set* method of every setting
+// configured in data which differs from previous.
+func (r *TeamSettingsResource) applySettings(ctx context.Context, data, previous TeamSettingsResourceModel) error {
+	teamID := data.TeamID.ValueString()
+
+	settings := []struct {
+		method string
+		param  string
+		value  types.String
+		old    types.String
+	}{
+		{"admin.teams.settings.setName", "name", data.Name, previous.Name},
+		{"admin.teams.settings.setDescription", "description", data.Description, previous.Description},
+		{"admin.teams.settings.setDiscoverability", "discoverability", data.Discoverability, previous.Discoverability},
+		{"admin.teams.settings.setIcon", "image_url", data.IconURL, previous.IconURL},
+	}
+
+	for _, setting := range settings {
+		if setting.value.IsNull() || setting.value.Equal(setting.old) {
+			continue
+		}
+
+		values := url.Values{
+			"team_id":     {teamID},
+			setting.param: {setting.value.ValueString()},
+		}
+		if err := r.client.callMethod(ctx, setting.method, values, &slack.SlackResponse{}); err != nil {
+			return fmt.Errorf("%s: %s", setting.method, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package slack
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSlackTeamSettingsTest(t *testing.T) {
+	if os.Getenv("TF_ACC") != "1" {
+		t.Skip("Acceptance tests skipped unless env 'TF_ACC' is set to 1")
+		return
+	}
+	testAccPreCheckAdmin(t)
+
+	resourceName := "slack_team_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackTeamSettingsConfig("Managed by Terraform", "invite_only"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "data.slack_team.current", "id"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Managed by Terraform"),
+					resource.TestCheckResourceAttr(resourceName, "discoverability", "invite_only"),
+					resource.TestCheckNoResourceAttr(resourceName, "name"),
+				),
+			},
+			{
+				Config: testAccSlackTeamSettingsConfig("Still managed by Terraform", "closed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "Still managed by Terraform"),
+					resource.TestCheckResourceAttr(resourceName, "discoverability", "closed"),
+				),
+			},
+			{
+				// Keeps the workspace name, so that every setting is managed
+				Config: testAccSlackTeamSettingsConfigWithName("Still managed by Terraform", "closed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "name", "data.slack_team.current", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSlackTeamSettingsConfig(description, discoverability string) string {
+	return fmt.Sprintf(`
+data slack_team current {}
+
+resource slack_team_settings test {
+  team_id         = data.slack_team.current.id
+  description     = "%s"
+  discoverability = "%s"
+}
+`, description, discoverability)
+}
+
+func testAccSlackTeamSettingsConfigWithName(description, discoverability string) string {
+	return fmt.Sprintf(`
+data slack_team current {}
+
+resource slack_team_settings test {
+  team_id         = data.slack_team.current.id
+  name            = data.slack_team.current.name
+  description     = "%s"
+  discoverability = "%s"
+}
+`, description, discoverability)
+}
@@ -0,0 +1,335 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/slack-go/slack"
+)
+
+const (
+	errUsersNotFound = "users_not_found"
+)
+
+var _ resource.Resource = &UserInviteResource{}
+var _ resource.ResourceWithValidateConfig = &UserInviteResource{}
+
+// NewUserInviteResource creates a new Slack user invite resource.
+func NewUserInviteResource() resource.Resource {
+	return &UserInviteResource{}
+}
+
+// UserInviteResource implements the Slack user invite resource.
+type UserInviteResource struct {
+	client *Client
+}
+
+// UserInviteResourceModel describes the user invite resource data model.
+type UserInviteResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	TeamID              types.String `tfsdk:"team_id"`
+	Email               types.String `tfsdk:"email"`
+	ChannelIDs          types.Set    `tfsdk:"channel_ids"`
+	RealName            types.String `tfsdk:"real_name"`
+	CustomMessage       types.String `tfsdk:"custom_message"`
+	IsRestricted        types.Bool   `tfsdk:"is_restricted"`
+	IsUltraRestricted   types.Bool   `tfsdk:"is_ultra_restricted"`
+	GuestExpiration     types.String `tfsdk:"guest_expiration"`
+	DeactivateOnDestroy types.Bool   `tfsdk:"deactivate_on_destroy"`
+	UserID              types.String `tfsdk:"user_id"`
+}
+
+// Metadata returns the resource type name.
+func (r *UserInviteResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_invite"
+}
+
+// Schema defines the schema for the resource.
+func (r *UserInviteResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Invites a user to a Slack workspace. `user_id` is known once the invitation is accepted.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The email address of the invited user",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workspace to invite the user to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email address of the person to invite",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"channel_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the channels the user joins when accepting the invitation",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"real_name": schema.StringAttribute{
+				MarkdownDescription: "The full name of the user",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"custom_message": schema.StringAttribute{
+				MarkdownDescription: "A message added to the invitation email",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"is_restricted": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is invited as a multi-channel guest. Default is false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"is_ultra_restricted": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is invited as a single-channel guest. Default is false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"guest_expiration": schema.StringAttribute{
+				MarkdownDescription: "When the guest account is deactivated, as an RFC 3339 timestamp. Only valid for guests.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"deactivate_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Whether to remove the user from the workspace when the resource is destroyed. Default is false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user, once the invitation is accepted",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the guest flags and expiration.
+func (r *UserInviteResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data UserInviteResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.IsRestricted.ValueBool() && data.IsUltraRestricted.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("is_ultra_restricted"), "Conflicting Guest Flags", "is_restricted and is_ultra_restricted cannot both be true")
+	}
+
+	if data.GuestExpiration.IsNull() || data.GuestExpiration.IsUnknown() {
+		return
+	}
+	if _, err := time.Parse(time.RFC3339, data.GuestExpiration.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("guest_expiration"), "Invalid Guest Expiration", fmt.Sprintf("guest_expiration must be an RFC 3339 timestamp: %s", err))
+	}
+	if data.IsRestricted.IsUnknown() || data.IsUltraRestricted.IsUnknown() {
+		return
+	}
+	if !data.IsRestricted.ValueBool() && !data.IsUltraRestricted.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("guest_expiration"), "Unexpected Guest Expiration", "guest_expiration can only be set when is_restricted or is_ultra_restricted is true")
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *UserInviteResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *slack.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create sends the invitation.
+func (r *UserInviteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserInviteResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var channelIDs []string
+	resp.Diagnostics.Append(data.ChannelIDs.ElementsAs(ctx, &channelIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	values := url.Values{
+		"team_id":             {data.TeamID.ValueString()},
+		"email":               {data.Email.ValueString()},
+		"channel_ids":         {strings.Join(channelIDs, ",")},
+		"is_restricted":       {strconv.FormatBool(data.IsRestricted.ValueBool())},
+		"is_ultra_restricted": {strconv.FormatBool(data.IsUltraRestricted.ValueBool())},
+	}
+	if !data.RealName.IsNull() {
+		values.Set("real_name", data.RealName.ValueString())
+	}
+	if !data.CustomMessage.IsNull() {
+		values.Set("custom_message", data.CustomMessage.ValueString())
+	}
+	if !data.GuestExpiration.IsNull() {
+		expiration, err := time.Parse(time.RFC3339, data.GuestExpiration.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Guest Expiration", err.Error())
+			return
+		}
+		values.Set("guest_expiration_ts", strconv.FormatInt(expiration.Unix(), 10))
+	}
+
+	if err := r.client.callMethod(ctx, "admin.users.invite", values, &slack.SlackResponse{}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to invite %s: %s", data.Email.ValueString(), err))
+		return
+	}
+
+	data.ID = data.Email
+	data.UserID = types.StringNull()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read looks up the user by email to know whether the invitation was accepted.
+func (r *UserInviteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserInviteResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID, err := r.lookupUserID(ctx, data.Email.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to look up user %s: %s", data.Email.ValueString(), err))
+		return
+	}
+	data.UserID = userID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only changes deactivate_on_destroy, every other argument requires a
+// replacement.
+func (r *UserInviteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state UserInviteResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = state.ID
+	data.UserID = state.UserID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the user from the workspace when deactivate_on_destroy is set.
+func (r *UserInviteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UserInviteResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.DeactivateOnDestroy.ValueBool() {
+		return
+	}
+
+	userID, err := r.lookupUserID(ctx, data.Email.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to look up user %s: %s", data.Email.ValueString(), err))
+		return
+	}
+
+	if userID.IsNull() {
+		resp.Diagnostics.AddWarning(
+			"Pending Invitation Not Revoked",
+			fmt.Sprintf("The Web API has no method to revoke the pending invitation of %s, it must be revoked from the admin settings in Slack.", data.Email.ValueString()),
+		)
+		return
+	}
+
+	values := url.Values{
+		"team_id": {data.TeamID.ValueString()},
+		"user_id": {userID.ValueString()},
+	}
+	err = r.client.callMethod(ctx, "admin.users.remove", values, &slack.SlackResponse{})
+	if err != nil && err.Error() != errUserNotFound {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove user %s: %s", userID.ValueString(), err))
+		return
+	}
+}
+
+// lookupUserID returns the ID of the user with the given email, or null while
+// the invitation is pending.
+func (r *UserInviteResource) lookupUserID(ctx context.Context, email string) (types.String, error) {
+	user, err := r.client.GetUserByEmailContext(ctx, email)
+	if err != nil {
+		if err.Error() == errUsersNotFound {
+			return types.StringNull(), nil
+		}
+		return types.StringNull(), err
+	}
+
+	return types.StringValue(user.ID), nil
+}
@@ -0,0 +1,62 @@
+package slack
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSlackUserInviteTest(t *testing.T) {
+	if os.Getenv("TF_ACC") != "1" {
+		t.Skip("Acceptance tests skipped unless env 'TF_ACC' is set to 1")
+		return
+	}
+	testAccPreCheckAdmin(t)
+	email := os.Getenv("SLACK_TEST_INVITE_EMAIL")
+	if email == "" {
+		t.Skip("User invite acceptance tests skipped unless env 'SLACK_TEST_INVITE_EMAIL' is set")
+	}
+
+	resourceName := "slack_user_invite.test"
+	channel := createTestConversation(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackUserInviteConfig(channel.ID, email, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", email),
+					resource.TestCheckResourceAttr(resourceName, "channel_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "is_restricted", "true"),
+					resource.TestCheckResourceAttr(resourceName, "deactivate_on_destroy", "false"),
+					resource.TestCheckNoResourceAttr(resourceName, "user_id"),
+				),
+			},
+			{
+				Config: testAccSlackUserInviteConfig(channel.ID, email, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "deactivate_on_destroy", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSlackUserInviteConfig(channelID, email string, deactivateOnDestroy bool) string {
+	return fmt.Sprintf(`
+data slack_team current {}
+
+resource slack_user_invite test {
+  team_id               = data.slack_team.current.id
+  email                 = "%s"
+  channel_ids           = ["%s"]
+  is_restricted         = true
+  custom_message        = "Invited by the Terraform acceptance tests"
+  deactivate_on_destroy = %t
+}
+`, email, channelID, deactivateOnDestroy)
+}
@@ -0,0 +1,322 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/slack-go/slack"
+)
+
+const (
+	errUserNotFound = "user_not_found"
+)
+
+var _ resource.Resource = &UserProfileResource{}
+var _ resource.ResourceWithImportState = &UserProfileResource{}
+
+// NewUserProfileResource creates a new Slack user profile resource.
+func NewUserProfileResource() resource.Resource {
+	return &UserProfileResource{}
+}
+
+// UserProfileResource implements the Slack user profile resource.
+type UserProfileResource struct {
+	client *Client
+}
+
+// UserProfileResourceModel describes the user profile resource data model.
+type UserProfileResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	UserID   types.String `tfsdk:"user_id"`
+	Title    types.String `tfsdk:"title"`
+	Phone    types.String `tfsdk:"phone"`
+	Pronouns types.String `tfsdk:"pronouns"`
+	Fields   types.Map    `tfsdk:"fields"`
+}
+
+// userProfile is the part of a user profile managed by the resource. Unlike
+// slack.UserProfile it includes the pronouns.
+type userProfile struct {
+	Title    string                        `json:"title"`
+	Phone    string                        `json:"phone"`
+	Pronouns string                        `json:"pronouns"`
+	Fields   slack.UserProfileCustomFields `json:"fields"`
+}
+
+// userProfileResponse is the users.profile.get answer.
+type userProfileResponse struct {
+	Profile userProfile `json:"profile"`
+	slack.SlackResponse
+}
+
+// Metadata returns the resource type name.
+func (r *UserProfileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_profile"
+}
+
+// Schema defines the schema for the resource.
+func (r *UserProfileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages some attributes of the profile of a Slack user. Only the attributes set in the configuration are changed.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The user ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user whose profile is managed",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "The job title of the user",
+				Optional:            true,
+			},
+			"phone": schema.StringAttribute{
+				MarkdownDescription: "The phone number of the user",
+				Optional:            true,
+			},
+			"pronouns": schema.StringAttribute{
+				MarkdownDescription: "The pronouns of the user",
+				Optional:            true,
+			},
+			"fields": schema.MapAttribute{
+				MarkdownDescription: "Values of custom profile fields, keyed by the label of the field",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *UserProfileResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *slack.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create sets the configured attributes of the profile.
+func (r *UserProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserProfileResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setProfile(ctx, data, UserProfileResourceModel{}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set user profile: %s", err))
+		return
+	}
+
+	data.ID = data.UserID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read reads the managed attributes of the profile.
+func (r *UserProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserProfileResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var profile userProfileResponse
+	if err := r.client.callMethod(ctx, "users.profile.get", url.Values{"user": {data.ID.ValueString()}}, &profile); err != nil {
+		if err.Error() == errUserNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user profile: %s", err))
+		return
+	}
+
+	data.UserID = data.ID
+	if !data.Title.IsNull() {
+		data.Title = types.StringValue(profile.Profile.Title)
+	}
+	if !data.Phone.IsNull() {
+		data.Phone = types.StringValue(profile.Profile.Phone)
+	}
+	if !data.Pronouns.IsNull() {
+		data.Pronouns = types.StringValue(profile.Profile.Pronouns)
+	}
+
+	if !data.Fields.IsNull() {
+		var managed map[string]string
+		resp.Diagnostics.Append(data.Fields.ElementsAs(ctx, &managed, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		fieldIDs, err := teamProfileFieldIDs(ctx, r.client)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team profile: %s", err))
+			return
+		}
+
+		values := profile.Profile.Fields.ToMap()
+		for label := range managed {
+			// A field deleted from the team profile reads as empty
+			managed[label] = values[fieldIDs[label]].Value
+		}
+
+		fields, diags := types.MapValueFrom(ctx, types.StringType, managed)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Fields = fields
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update sets the changed attributes of the profile, and clears the ones
+// removed from the configuration.
+func (r *UserProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state UserProfileResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setProfile(ctx, data, state); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set user profile: %s", err))
+		return
+	}
+
+	data.ID = state.ID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete leaves the profile untouched, it only stops managing it.
+func (r *UserProfileResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// ImportState imports a Slack user profile using the user ID.
+func (r *UserProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setProfile sends the attributes of data to users.profile.set. The
+// attributes only present in previous, the former state, are cleared.
+func (r *UserProfileResource) setProfile(ctx context.Context, data, previous UserProfileResourceModel) error {
+	profile := map[string]interface{}{}
+
+	for key, attrs := range map[string][2]types.String{
+		"title":    {data.Title, previous.Title},
+		"phone":    {data.Phone, previous.Phone},
+		"pronouns": {data.Pronouns, previous.Pronouns},
+	} {
+		if !attrs[0].IsNull() || !attrs[1].IsNull() {
+			profile[key] = attrs[0].ValueString()
+		}
+	}
+
+	var fields, previousFields map[string]string
+	if !data.Fields.IsNull() {
+		if diags := data.Fields.ElementsAs(ctx, &fields, false); diags.HasError() {
+			return fmt.Errorf("unable to read fields")
+		}
+	}
+	if !previous.Fields.IsNull() {
+		if diags := previous.Fields.ElementsAs(ctx, &previousFields, false); diags.HasError() {
+			return fmt.Errorf("unable to read fields")
+		}
+	}
+
+	if len(fields) > 0 || len(previousFields) > 0 {
+		fieldIDs, err := teamProfileFieldIDs(ctx, r.client)
+		if err != nil {
+			return fmt.Errorf("unable to read team profile: %s", err)
+		}
+
+		custom := map[string]slack.UserProfileCustomField{}
+		for label := range previousFields {
+			if id, ok := fieldIDs[label]; ok {
+				custom[id] = slack.UserProfileCustomField{}
+			}
+		}
+
+		var unknown []string
+		for label, value := range fields {
+			id, ok := fieldIDs[label]
+			if !ok {
+				unknown = append(unknown, label)
+				continue
+			}
+			custom[id] = slack.UserProfileCustomField{Value: value}
+		}
+		if len(unknown) > 0 {
+			sort.Strings(unknown)
+			return fmt.Errorf("no custom profile field labelled %s", strings.Join(unknown, ", "))
+		}
+
+		profile["fields"] = custom
+	}
+
+	if len(profile) == 0 {
+		return nil
+	}
+
+	encoded, err := json.Marshal(profile)
+	if err != nil {
+		return err
+	}
+
+	values := url.Values{
+		"user":    {data.UserID.ValueString()},
+		"profile": {string(encoded)},
+	}
+	return r.client.callMethod(ctx, "users.profile.set", values, &slack.SlackResponse{})
+}
+
+// teamProfileFieldIDs maps the labels of the custom profile fields of the
+// team to their IDs.
+func teamProfileFieldIDs(ctx context.Context, client *Client) (map[string]string, error) {
+	profile, err := getTeamProfile(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]string, len(profile.Profile.Fields))
+	for _, field := range profile.Profile.Fields {
+		ids[field.Label] = field.ID
+	}
+
+	return ids, nil
+}
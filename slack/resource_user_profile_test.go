@@ -0,0 +1,71 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/slack-go/slack"
+)
+
+func TestAccSlackUserProfileTest(t *testing.T) {
+	if os.Getenv("TF_ACC") != "1" {
+		t.Skip("Acceptance tests skipped unless env 'TF_ACC' is set to 1")
+		return
+	}
+	testAccPreCheckAdmin(t)
+
+	resourceName := "slack_user_profile.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackUserProfileConfig(testUser00.id, "Engineer", "they/them"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", testUser00.id),
+					resource.TestCheckResourceAttr(resourceName, "title", "Engineer"),
+					resource.TestCheckResourceAttr(resourceName, "pronouns", "they/them"),
+					resource.TestCheckNoResourceAttr(resourceName, "phone"),
+				),
+			},
+			{
+				Config: testAccSlackUserProfileConfig(testUser00.id, "Senior Engineer", "she/her"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "title", "Senior Engineer"),
+					resource.TestCheckResourceAttr(resourceName, "pronouns", "she/her"),
+					testCheckSlackUserTitle(testUser00.id, "Senior Engineer"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckSlackUserTitle(userID, title string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		profile, err := getTestSlackClient().GetUserProfileContext(context.Background(), &slack.GetUserProfileParameters{UserID: userID})
+		if err != nil {
+			return fmt.Errorf("couldn't get profile of %s: %s", userID, err)
+		}
+
+		if profile.Title != title {
+			return fmt.Errorf("expected title %q for %s, got %q", title, userID, profile.Title)
+		}
+
+		return nil
+	}
+}
+
+func testAccSlackUserProfileConfig(userID, title, pronouns string) string {
+	return fmt.Sprintf(`
+resource slack_user_profile test {
+  user_id  = "%s"
+  title    = "%s"
+  pronouns = "%s"
+}
+`, userID, title, pronouns)
+}
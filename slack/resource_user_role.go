@@ -0,0 +1,269 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/slack-go/slack"
+)
+
+const (
+	userRoleRegular            = "regular"
+	userRoleAdmin              = "admin"
+	userRoleOwner              = "owner"
+	userRoleMultiChannelGuest  = "multi_channel_guest"
+	userRoleSingleChannelGuest = "single_channel_guest"
+)
+
+// userRoleMethods maps the roles which can be set to their admin.users method.
+var userRoleMethods = map[string]string{
+	userRoleRegular: "admin.users.setRegular",
+	userRoleAdmin:   "admin.users.setAdmin",
+	userRoleOwner:   "admin.users.setOwner",
+}
+
+var _ resource.Resource = &UserRoleResource{}
+var _ resource.ResourceWithImportState = &UserRoleResource{}
+
+// NewUserRoleResource creates a new Slack user role resource.
+func NewUserRoleResource() resource.Resource {
+	return &UserRoleResource{}
+}
+
+// UserRoleResource implements the Slack user role resource.
+type UserRoleResource struct {
+	client *Client
+}
+
+// UserRoleResourceModel describes the user role resource data model.
+type UserRoleResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	TeamID       types.String `tfsdk:"team_id"`
+	UserID       types.String `tfsdk:"user_id"`
+	Role         types.String `tfsdk:"role"`
+	ExpirationTS types.Int64  `tfsdk:"expiration_ts"`
+}
+
+// Metadata returns the resource type name.
+func (r *UserRoleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_role"
+}
+
+// Schema defines the schema for the resource.
+func (r *UserRoleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the role of a Slack user, and the expiration of guest accounts",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The resource ID, in the form `<team_id>/<user_id>`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workspace",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "The role of the user, one of `regular`, `admin` or `owner`. When not set, it reports the current role, which can also be `multi_channel_guest` or `single_channel_guest`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(userRoleRegular, userRoleAdmin, userRoleOwner),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expiration_ts": schema.Int64Attribute{
+				MarkdownDescription: "When the guest account is deactivated, as a Unix timestamp. Only valid for guests.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.ConflictsWith(path.MatchRoot("role")),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *UserRoleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *slack.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create sets the role and expiration of the user.
+func (r *UserRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserRoleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(ctx, &data, UserRoleResourceModel{}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set role of user %s: %s", data.UserID.ValueString(), err))
+		return
+	}
+
+	data.ID = types.StringValue(data.TeamID.ValueString() + "/" + data.UserID.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read derives the role of the user from its users.info flags.
+func (r *UserRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserRoleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.client.GetUserInfoContext(ctx, data.UserID.ValueString())
+	if err != nil {
+		if err.Error() == errUserNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user %s: %s", data.UserID.ValueString(), err))
+		return
+	}
+
+	// A deactivated user has no role left to manage
+	if user.Deleted {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// users.info does not return the expiration, so the configured one is kept
+	data.Role = types.StringValue(userRole(user))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update sets the changed role and expiration of the user.
+func (r *UserRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state UserRoleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(ctx, &data, state); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set role of user %s: %s", data.UserID.ValueString(), err))
+		return
+	}
+
+	data.ID = state.ID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete leaves the role of the user as it is, it only stops managing it.
+func (r *UserRoleResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// ImportState imports the role of a Slack user using `<team_id>/<user_id>`.
+func (r *UserRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <team_id>/<user_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), parts[1])...)
+}
+
+// apply sets the role and expiration of data which differ from previous, and
+// resolves the role when it is not configured.
+func (r *UserRoleResource) apply(ctx context.Context, data *UserRoleResourceModel, previous UserRoleResourceModel) error {
+	values := url.Values{
+		"team_id": {data.TeamID.ValueString()},
+		"user_id": {data.UserID.ValueString()},
+	}
+
+	if !data.Role.IsUnknown() && !data.Role.Equal(previous.Role) {
+		method := userRoleMethods[data.Role.ValueString()]
+		if err := r.client.callMethod(ctx, method, values, &slack.SlackResponse{}); err != nil {
+			return fmt.Errorf("%s: %s", method, err)
+		}
+	}
+
+	if !data.ExpirationTS.IsNull() && !data.ExpirationTS.Equal(previous.ExpirationTS) {
+		values.Set("expiration_ts", strconv.FormatInt(data.ExpirationTS.ValueInt64(), 10))
+		if err := r.client.callMethod(ctx, "admin.users.setExpiration", values, &slack.SlackResponse{}); err != nil {
+			return fmt.Errorf("admin.users.setExpiration: %s", err)
+		}
+	}
+
+	if data.Role.IsUnknown() {
+		user, err := r.client.GetUserInfoContext(ctx, data.UserID.ValueString())
+		if err != nil {
+			return err
+		}
+		data.Role = types.StringValue(userRole(user))
+	}
+
+	return nil
+}
+
+// userRole returns the role of user derived from its flags.
+func userRole(user *slack.User) string {
+	switch {
+	case user.IsOwner || user.IsPrimaryOwner:
+		return userRoleOwner
+	case user.IsAdmin:
+		return userRoleAdmin
+	case user.IsUltraRestricted:
+		return userRoleSingleChannelGuest
+	case user.IsRestricted:
+		return userRoleMultiChannelGuest
+	default:
+		return userRoleRegular
+	}
+}
@@ -0,0 +1,56 @@
+package slack
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSlackUserRoleTest(t *testing.T) {
+	if os.Getenv("TF_ACC") != "1" {
+		t.Skip("Acceptance tests skipped unless env 'TF_ACC' is set to 1")
+		return
+	}
+	testAccPreCheckAdmin(t)
+
+	resourceName := "slack_user_role.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackUserRoleConfig(testUser01.id, "admin"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "user_id", testUser01.id),
+					resource.TestCheckResourceAttr(resourceName, "role", "admin"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSlackUserRoleConfig(testUser01.id, "regular"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "role", "regular"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSlackUserRoleConfig(userID, role string) string {
+	return fmt.Sprintf(`
+data slack_team current {}
+
+resource slack_user_role test {
+  team_id = data.slack_team.current.id
+  user_id = "%s"
+  role    = "%s"
+}
+`, userID, role)
+}
@@ -0,0 +1,259 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/slack-go/slack"
+)
+
+var _ resource.Resource = &UserStatusResource{}
+var _ resource.ResourceWithImportState = &UserStatusResource{}
+var _ resource.ResourceWithModifyPlan = &UserStatusResource{}
+
+// NewUserStatusResource creates a new Slack user status resource.
+func NewUserStatusResource() resource.Resource {
+	return &UserStatusResource{}
+}
+
+// UserStatusResource implements the Slack user status resource.
+type UserStatusResource struct {
+	client *Client
+}
+
+// UserStatusResourceModel describes the user status resource data model.
+type UserStatusResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	UserID           types.String `tfsdk:"user_id"`
+	StatusText       types.String `tfsdk:"status_text"`
+	StatusEmoji      types.String `tfsdk:"status_emoji"`
+	StatusExpiration types.Int64  `tfsdk:"status_expiration"`
+}
+
+// Metadata returns the resource type name.
+func (r *UserStatusResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_status"
+}
+
+// Schema defines the schema for the resource.
+func (r *UserStatusResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the custom status of a Slack user",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The user ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status_text": schema.StringAttribute{
+				MarkdownDescription: "The text of the status, up to 100 characters",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+					stringvalidator.AtLeastOneOf(path.MatchRoot("status_emoji")),
+				},
+			},
+			"status_emoji": schema.StringAttribute{
+				MarkdownDescription: "The emoji of the status, e.g. `:pager:`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^:[^:\s]+:$`), "must be an emoji code, e.g. :pager:"),
+				},
+			},
+			"status_expiration": schema.Int64Attribute{
+				MarkdownDescription: "When the status is cleared, as a Unix timestamp. The status never expires if not set.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *UserStatusResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *slack.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan rejects a status_expiration in the past when the status is set,
+// as Slack would clear the status right away and the next plan would set it
+// again.
+func (r *UserStatusResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan UserStatusResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.StatusExpiration.IsNull() || plan.StatusExpiration.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state UserStatusResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() || state.StatusExpiration.Equal(plan.StatusExpiration) {
+			return
+		}
+	}
+
+	if plan.StatusExpiration.ValueInt64() <= time.Now().Unix() {
+		resp.Diagnostics.AddAttributeError(path.Root("status_expiration"), "Expired Status",
+			fmt.Sprintf("status_expiration %s is in the past. If the status already expired, remove it from the configuration or set a later expiration.",
+				time.Unix(plan.StatusExpiration.ValueInt64(), 0).UTC().Format(time.RFC3339)))
+	}
+}
+
+// Create sets the status of the user.
+func (r *UserStatusResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserStatusResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setStatus(ctx, data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set status of user %s: %s", data.UserID.ValueString(), err))
+		return
+	}
+
+	data.ID = data.UserID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read reads the status of the user, and removes the resource once the status
+// is cleared or expired.
+func (r *UserStatusResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserStatusResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err := r.client.GetUserProfileContext(ctx, &slack.GetUserProfileParameters{UserID: data.ID.ValueString()})
+	if err != nil {
+		if err.Error() == errUserNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read profile of user %s: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	expired := profile.StatusExpiration != 0 && int64(profile.StatusExpiration) <= time.Now().Unix()
+	if expired || (profile.StatusText == "" && profile.StatusEmoji == "") {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	imported := data.StatusText.IsNull() && data.StatusEmoji.IsNull()
+
+	data.UserID = data.ID
+	data.StatusText = types.StringNull()
+	if profile.StatusText != "" {
+		data.StatusText = types.StringValue(profile.StatusText)
+	}
+	// Slack gives a default emoji to statuses set without one
+	if !data.StatusEmoji.IsNull() || imported {
+		data.StatusEmoji = types.StringNull()
+		if profile.StatusEmoji != "" {
+			data.StatusEmoji = types.StringValue(profile.StatusEmoji)
+		}
+	}
+	data.StatusExpiration = types.Int64Null()
+	if profile.StatusExpiration != 0 {
+		data.StatusExpiration = types.Int64Value(int64(profile.StatusExpiration))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update sets the status of the user.
+func (r *UserStatusResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state UserStatusResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setStatus(ctx, data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set status of user %s: %s", data.UserID.ValueString(), err))
+		return
+	}
+
+	data.ID = state.ID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete clears the status of the user.
+func (r *UserStatusResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UserStatusResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.SetUserCustomStatusContextWithUser(ctx, data.ID.ValueString(), "", "", 0)
+	if err != nil && err.Error() != errUserNotFound {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to clear status of user %s: %s", data.ID.ValueString(), err))
+		return
+	}
+}
+
+// ImportState imports the status of a Slack user using its ID.
+func (r *UserStatusResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setStatus sets the status of the user with users.profile.set.
+func (r *UserStatusResource) setStatus(ctx context.Context, data UserStatusResourceModel) error {
+	return r.client.SetUserCustomStatusContextWithUser(
+		ctx,
+		data.UserID.ValueString(),
+		data.StatusText.ValueString(),
+		data.StatusEmoji.ValueString(),
+		data.StatusExpiration.ValueInt64(),
+	)
+}
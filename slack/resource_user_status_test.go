@@ -0,0 +1,63 @@
+package slack
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSlackUserStatusTest(t *testing.T) {
+	if os.Getenv("TF_ACC") != "1" {
+		t.Skip("Acceptance tests skipped unless env 'TF_ACC' is set to 1")
+		return
+	}
+
+	resourceName := "slack_user_status.test"
+	expiration := time.Now().Add(24 * time.Hour).Unix()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackUserStatusConfig(testUserCreator.id, "on-call", expiration),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", testUserCreator.id),
+					resource.TestCheckResourceAttr(resourceName, "status_text", "on-call"),
+					resource.TestCheckResourceAttr(resourceName, "status_emoji", ":pager:"),
+					resource.TestCheckResourceAttr(resourceName, "status_expiration", fmt.Sprint(expiration)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSlackUserStatusConfig(testUserCreator.id, "handing over", expiration),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status_text", "handing over"),
+				),
+			},
+			{
+				Config:      testAccSlackUserStatusConfig(testUserCreator.id, "handing over", time.Now().Add(-time.Hour).Unix()),
+				ExpectError: regexp.MustCompile("is in the past"),
+			},
+		},
+	})
+}
+
+func testAccSlackUserStatusConfig(userID, text string, expiration int64) string {
+	return fmt.Sprintf(`
+resource slack_user_status test {
+  user_id           = "%s"
+  status_text       = "%s"
+  status_emoji      = ":pager:"
+  status_expiration = %d
+}
+`, userID, text, expiration)
+}
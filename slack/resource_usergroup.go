@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/slack-go/slack"
@@ -14,6 +15,7 @@ import (
 
 var _ resource.Resource = &UsergroupResource{}
 var _ resource.ResourceWithImportState = &UsergroupResource{}
+var _ resource.ResourceWithIdentity = &UsergroupResource{}
 
 // NewUsergroupResource creates a new Slack usergroup resource.
 func NewUsergroupResource() resource.Resource {
@@ -22,7 +24,7 @@ func NewUsergroupResource() resource.Resource {
 
 // UsergroupResource implements the Slack usergroup resource.
 type UsergroupResource struct {
-	client *slack.Client
+	client *Client
 }
 
 // UsergroupResourceModel describes the usergroup resource data model.
@@ -80,13 +82,26 @@ func (r *UsergroupResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+// IdentitySchema defines the identity of the resource, used to import it and
+// by the list resource.
+func (r *UsergroupResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The usergroup ID",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *UsergroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*slack.Client)
+	client, ok := req.ProviderData.(*Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
@@ -188,6 +203,7 @@ func (r *UsergroupResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("id"), data.ID)...)
 }
 
 // Read reads the current state of a Slack usergroup.
@@ -231,6 +247,7 @@ func (r *UsergroupResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("id"), data.ID)...)
 }
 
 // Update updates a Slack usergroup.
@@ -369,5 +386,5 @@ func (r *UsergroupResource) Delete(ctx context.Context, req resource.DeleteReque
 
 // ImportState imports a Slack usergroup using its ID.
 func (r *UsergroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -0,0 +1,292 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/slack-go/slack"
+)
+
+var _ resource.Resource = &UsergroupChannelLinkResource{}
+var _ resource.ResourceWithImportState = &UsergroupChannelLinkResource{}
+
+// NewUsergroupChannelLinkResource creates a new Slack usergroup channel link resource.
+func NewUsergroupChannelLinkResource() resource.Resource {
+	return &UsergroupChannelLinkResource{}
+}
+
+// UsergroupChannelLinkResource implements the Slack usergroup channel link resource.
+type UsergroupChannelLinkResource struct {
+	client *Client
+}
+
+// UsergroupChannelLinkResourceModel describes the usergroup channel link resource data model.
+type UsergroupChannelLinkResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	UsergroupID types.String `tfsdk:"usergroup_id"`
+	ChannelIDs  types.Set    `tfsdk:"channel_ids"`
+	TeamIDs     types.Set    `tfsdk:"team_ids"`
+}
+
+// listUsergroupChannelsResponse is the admin.usergroups.listChannels answer.
+type listUsergroupChannelsResponse struct {
+	Channels []struct {
+		ID string `json:"id"`
+	} `json:"channels"`
+	slack.SlackResponse
+}
+
+// Metadata returns the resource type name.
+func (r *UsergroupChannelLinkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_usergroup_channel_link"
+}
+
+// Schema defines the schema for the resource.
+func (r *UsergroupChannelLinkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the channels linked to an IDP usergroup, whose membership is then restricted to the members of the usergroup",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The usergroup ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"usergroup_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the IDP usergroup",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"channel_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the channels linked to the usergroup",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"team_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the workspaces of an Enterprise Grid organization the usergroup is added to",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *UsergroupChannelLinkResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *slack.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create adds the usergroup to the workspaces, then links the channels.
+func (r *UsergroupChannelLinkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UsergroupChannelLinkResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var teamIDs, channelIDs []string
+	resp.Diagnostics.Append(data.TeamIDs.ElementsAs(ctx, &teamIDs, false)...)
+	resp.Diagnostics.Append(data.ChannelIDs.ElementsAs(ctx, &channelIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	usergroupID := data.UsergroupID.ValueString()
+
+	if err := r.addTeams(ctx, usergroupID, teamIDs); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add usergroup %s to workspaces: %s", usergroupID, err))
+		return
+	}
+
+	if err := r.updateChannels(ctx, "admin.usergroups.addChannels", usergroupID, channelIDs); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to link channels to usergroup %s: %s", usergroupID, err))
+		return
+	}
+
+	data.ID = data.UsergroupID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read reads the channels linked to the usergroup.
+func (r *UsergroupChannelLinkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UsergroupChannelLinkResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var linked listUsergroupChannelsResponse
+	err := r.client.callMethod(ctx, "admin.usergroups.listChannels", url.Values{"usergroup_id": {data.ID.ValueString()}}, &linked)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list channels of usergroup %s: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	channelIDs := make([]string, 0, len(linked.Channels))
+	for _, channel := range linked.Channels {
+		channelIDs = append(channelIDs, channel.ID)
+	}
+
+	if len(channelIDs) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	channels, diags := types.SetValueFrom(ctx, types.StringType, channelIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The Web API does not list the workspaces of a usergroup, so the
+	// configured team_ids are kept
+	data.UsergroupID = data.ID
+	data.ChannelIDs = channels
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update adds the new workspaces, then links and unlinks channels.
+func (r *UsergroupChannelLinkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state UsergroupChannelLinkResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var teamIDs, oldTeamIDs, channelIDs, oldChannelIDs []string
+	resp.Diagnostics.Append(data.TeamIDs.ElementsAs(ctx, &teamIDs, false)...)
+	resp.Diagnostics.Append(state.TeamIDs.ElementsAs(ctx, &oldTeamIDs, false)...)
+	resp.Diagnostics.Append(data.ChannelIDs.ElementsAs(ctx, &channelIDs, false)...)
+	resp.Diagnostics.Append(state.ChannelIDs.ElementsAs(ctx, &oldChannelIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	usergroupID := state.ID.ValueString()
+
+	var newTeamIDs []string
+	for _, teamID := range teamIDs {
+		if !contains(oldTeamIDs, teamID) {
+			newTeamIDs = append(newTeamIDs, teamID)
+		}
+	}
+	if err := r.addTeams(ctx, usergroupID, newTeamIDs); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add usergroup %s to workspaces: %s", usergroupID, err))
+		return
+	}
+
+	var added, removed []string
+	for _, channelID := range channelIDs {
+		if !contains(oldChannelIDs, channelID) {
+			added = append(added, channelID)
+		}
+	}
+	for _, channelID := range oldChannelIDs {
+		if !contains(channelIDs, channelID) {
+			removed = append(removed, channelID)
+		}
+	}
+
+	if err := r.updateChannels(ctx, "admin.usergroups.addChannels", usergroupID, added); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to link channels to usergroup %s: %s", usergroupID, err))
+		return
+	}
+	if err := r.updateChannels(ctx, "admin.usergroups.removeChannels", usergroupID, removed); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to unlink channels from usergroup %s: %s", usergroupID, err))
+		return
+	}
+
+	data.ID = state.ID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete unlinks the channels from the usergroup. The usergroup stays in the
+// workspaces it was added to, the Web API has no method to remove it.
+func (r *UsergroupChannelLinkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UsergroupChannelLinkResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var channelIDs []string
+	resp.Diagnostics.Append(data.ChannelIDs.ElementsAs(ctx, &channelIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.updateChannels(ctx, "admin.usergroups.removeChannels", data.ID.ValueString(), channelIDs); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to unlink channels from usergroup %s: %s", data.ID.ValueString(), err))
+		return
+	}
+}
+
+// ImportState imports the channels linked to a usergroup using its ID.
+func (r *UsergroupChannelLinkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// updateChannels calls admin.usergroups.addChannels or removeChannels with
+// the given channels, if any.
+func (r *UsergroupChannelLinkResource) updateChannels(ctx context.Context, method, usergroupID string, channelIDs []string) error {
+	if len(channelIDs) == 0 {
+		return nil
+	}
+
+	values := url.Values{
+		"usergroup_id": {usergroupID},
+		"channel_ids":  {strings.Join(channelIDs, ",")},
+	}
+	return r.client.callMethod(ctx, method, values, &slack.SlackResponse{})
+}
+
+// addTeams adds the usergroup to the given workspaces, if any.
+func (r *UsergroupChannelLinkResource) addTeams(ctx context.Context, usergroupID string, teamIDs []string) error {
+	if len(teamIDs) == 0 {
+		return nil
+	}
+
+	values := url.Values{
+		"usergroup_id": {usergroupID},
+		"team_ids":     {strings.Join(teamIDs, ",")},
+	}
+	return r.client.callMethod(ctx, "admin.usergroups.addTeams", values, &slack.SlackResponse{})
+}
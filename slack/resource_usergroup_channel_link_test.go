@@ -0,0 +1,62 @@
+package slack
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSlackUsergroupChannelLinkTest(t *testing.T) {
+	if os.Getenv("TF_ACC") != "1" {
+		t.Skip("Acceptance tests skipped unless env 'TF_ACC' is set to 1")
+		return
+	}
+	testAccPreCheckAdmin(t)
+	usergroupID := strings.Split(os.Getenv("SLACK_TEST_IDP_USERGROUP_IDS"), ",")[0]
+	if usergroupID == "" {
+		t.Skip("Usergroup channel link acceptance tests skipped unless env 'SLACK_TEST_IDP_USERGROUP_IDS' lists an IDP usergroup")
+	}
+
+	resourceName := "slack_usergroup_channel_link.test"
+	channel00 := createTestConversation(t)
+	channel01 := createTestConversation(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackUsergroupChannelLinkConfig(usergroupID, channel00.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", usergroupID),
+					resource.TestCheckResourceAttr(resourceName, "channel_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "channel_ids.*", channel00.ID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSlackUsergroupChannelLinkConfig(usergroupID, channel01.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "channel_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "channel_ids.*", channel01.ID),
+				),
+			},
+		},
+	})
+}
+
+func testAccSlackUsergroupChannelLinkConfig(usergroupID, channelID string) string {
+	return fmt.Sprintf(`
+resource slack_usergroup_channel_link test {
+  usergroup_id = "%s"
+  channel_ids  = ["%s"]
+}
+`, usergroupID, channelID)
+}